@@ -0,0 +1,3 @@
+data "sonarr_episodes" "example" {
+  series_id = 1
+}
\ No newline at end of file
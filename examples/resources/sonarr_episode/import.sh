@@ -0,0 +1,2 @@
+# import using the API/UI ID
+terraform import sonarr_episode.example 10
\ No newline at end of file
@@ -0,0 +1,6 @@
+resource "sonarr_episode" "example" {
+  series_id      = 1
+  season_number  = 0
+  episode_number = 1
+  monitored      = false
+}
\ No newline at end of file
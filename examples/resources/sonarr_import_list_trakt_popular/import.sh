@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_import_list_trakt_popular.example 1
\ No newline at end of file
+terraform import sonarr_import_list_trakt_popular.example 1
+
+# import using the name
+terraform import sonarr_import_list_trakt_popular.example name:Example
\ No newline at end of file
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_indexer_fanzub.example 1
\ No newline at end of file
+terraform import sonarr_indexer_fanzub.example 1
+
+# import using the name
+terraform import sonarr_indexer_fanzub.example name:Example
\ No newline at end of file
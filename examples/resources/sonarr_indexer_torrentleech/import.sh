@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_indexer_torrentleech.example 1
\ No newline at end of file
+terraform import sonarr_indexer_torrentleech.example 1
+
+# import using the name
+terraform import sonarr_indexer_torrentleech.example name:Example
\ No newline at end of file
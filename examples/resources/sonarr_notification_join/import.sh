@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_notification_join.example 1
\ No newline at end of file
+terraform import sonarr_notification_join.example 1
+
+# import using the name
+terraform import sonarr_notification_join.example name:Example
\ No newline at end of file
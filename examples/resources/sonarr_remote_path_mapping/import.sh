@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_remote_path_mapping.example 10
\ No newline at end of file
+terraform import sonarr_remote_path_mapping.example 10
+
+# import using the remote or local path
+terraform import sonarr_remote_path_mapping.example path:/transmission-download/
\ No newline at end of file
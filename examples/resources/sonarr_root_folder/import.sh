@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_root_folder.example 1
\ No newline at end of file
+terraform import sonarr_root_folder.example 1
+
+# import using the path
+terraform import sonarr_root_folder.example path:/tmp
\ No newline at end of file
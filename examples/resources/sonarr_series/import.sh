@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_series.example 10
\ No newline at end of file
+terraform import sonarr_series.example 10
+
+# import using the TVDB ID
+terraform import sonarr_series.example tvdb:81189
\ No newline at end of file
@@ -11,4 +11,28 @@ resource "sonarr_series" "example" {
 
   quality_profile_id = 1
   tags               = [1]
+
+  seasons = [
+    {
+      season_number = 0
+      monitored     = false
+    }
+  ]
+
+  add_options = {
+    monitor                     = "future"
+    search_for_missing_episodes = false
+  }
+}
+
+resource "sonarr_series" "lookup" {
+  term = "imdb:tt0386676"
+
+  monitored           = true
+  season_folder       = true
+  use_scene_numbering = false
+  path                = "/tmp/the_office"
+  root_folder_path    = "/tmp/"
+
+  quality_profile_id = 1
 }
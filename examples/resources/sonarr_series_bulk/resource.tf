@@ -0,0 +1,7 @@
+resource "sonarr_series_bulk" "example" {
+  series_ids         = [1, 2, 3]
+  quality_profile_id = 1
+  monitored          = true
+  series_type        = "standard"
+  tags               = [1]
+}
\ No newline at end of file
@@ -0,0 +1,149 @@
+package helpers
+
+import (
+	"net/http"
+	"sync"
+)
+
+// ReadCache holds list responses for the duration of a run.
+// It is safe for concurrent use and it is cleared on every write request.
+type ReadCache struct {
+	lists map[string]*cachedList
+	mu    sync.Mutex
+}
+
+type cachedList struct {
+	items any
+	index map[int32]int
+	err   error
+	once  sync.Once
+}
+
+// NewReadCache returns an empty cache.
+func NewReadCache() *ReadCache {
+	return &ReadCache{
+		lists: make(map[string]*cachedList),
+	}
+}
+
+// Clear drops all the cached lists.
+func (c *ReadCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.lists = make(map[string]*cachedList)
+}
+
+func (c *ReadCache) entry(kind string) *cachedList {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.lists[kind]; !ok {
+		c.lists[kind] = &cachedList{}
+	}
+
+	return c.lists[kind]
+}
+
+func (c *ReadCache) drop(kind string, entry *cachedList) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lists[kind] == entry {
+		delete(c.lists, kind)
+	}
+}
+
+// CachedList returns the list of objects of the given kind, calling list only once per run.
+func CachedList[T any](c *ReadCache, kind string, list func() ([]T, error), getID func(*T) int32) ([]T, error) {
+	items, _, err := cachedLoad(c, kind, list, getID)
+
+	return items, err
+}
+
+// CachedGet returns a single object from the cached list of the given kind.
+// ErrNotFound is returned if the object is not in the list.
+func CachedGet[T any](c *ReadCache, kind string, id int32, list func() ([]T, error), getID func(*T) int32) (*T, error) {
+	items, index, err := cachedLoad(c, kind, list, getID)
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := index[id]; ok {
+		item := items[i]
+
+		return &item, nil
+	}
+
+	return nil, ErrNotFound
+}
+
+// CachedFind returns the first object of the cached list of the given kind matching the key, nil if there is none.
+func CachedFind[T any](c *ReadCache, kind string, list func() ([]T, error), getID func(*T) int32, match func(*T) bool) (*T, error) {
+	items, _, err := cachedLoad(c, kind, list, getID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		if match(&items[i]) {
+			item := items[i]
+
+			return &item, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func cachedLoad[T any](c *ReadCache, kind string, list func() ([]T, error), getID func(*T) int32) ([]T, map[int32]int, error) {
+	if c == nil {
+		items, err := list()
+
+		return items, indexList(items, getID), err
+	}
+
+	entry := c.entry(kind)
+	entry.once.Do(func() {
+		items, err := list()
+		entry.items, entry.index, entry.err = items, indexList(items, getID), err
+	})
+
+	// errors are not kept so that the next read can try again
+	if entry.err != nil {
+		c.drop(kind, entry)
+
+		return nil, nil, entry.err
+	}
+
+	items, _ := entry.items.([]T)
+
+	return items, entry.index, nil
+}
+
+func indexList[T any](items []T, getID func(*T) int32) map[int32]int {
+	index := make(map[int32]int, len(items))
+	for i := range items {
+		index[getID(&items[i])] = i
+	}
+
+	return index
+}
+
+// CacheTransport clears the cache on any request that is not a read.
+type CacheTransport struct {
+	Next  http.RoundTripper
+	Cache *ReadCache
+}
+
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.Next.RoundTrip(req)
+	}
+
+	// clear before and after to drop lists fetched while the write was in flight
+	t.Cache.Clear()
+	defer t.Cache.Clear()
+
+	return t.Next.RoundTrip(req)
+}
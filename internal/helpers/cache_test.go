@@ -0,0 +1,129 @@
+package helpers
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cacheItem struct {
+	ID int32
+}
+
+func TestCachedGet(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+
+	list := func() ([]cacheItem, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls++
+
+		return []cacheItem{{ID: 1}, {ID: 2}}, nil
+	}
+	getID := func(i *cacheItem) int32 { return i.ID }
+	cache := NewReadCache()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			item, err := CachedGet(cache, "item", 2, list, getID)
+			assert.NoError(t, err)
+			assert.Equal(t, int32(2), item.ID)
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, 1, calls)
+
+	_, err := CachedGet(cache, "item", 3, list, getID)
+	assert.True(t, IsNotFoundError(err))
+	assert.Equal(t, 1, calls)
+
+	cache.Clear()
+
+	_, err = CachedGet(cache, "item", 1, list, getID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestCachedGetError(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	list := func() ([]cacheItem, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("list error")
+		}
+
+		return []cacheItem{{ID: 1}}, nil
+	}
+	getID := func(i *cacheItem) int32 { return i.ID }
+	cache := NewReadCache()
+
+	_, err := CachedGet(cache, "item", 1, list, getID)
+	assert.EqualError(t, err, "list error")
+
+	item, err := CachedGet(cache, "item", 1, list, getID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), item.ID)
+}
+
+func TestCacheTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cache := NewReadCache()
+	client := &http.Client{Transport: &CacheTransport{Next: http.DefaultTransport, Cache: cache}}
+	list := func() ([]cacheItem, error) { return []cacheItem{{ID: 1}}, nil }
+	getID := func(i *cacheItem) int32 { return i.ID }
+
+	_, _ = CachedList(cache, "item", list, getID)
+
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Len(t, cache.lists, 1)
+
+	resp, err = client.Post(server.URL, "application/json", nil)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, cache.lists)
+}
+
+func TestCachedFind(t *testing.T) {
+	t.Parallel()
+
+	list := func() ([]cacheItem, error) {
+		return []cacheItem{{ID: 1}, {ID: 2}}, nil
+	}
+	getID := func(i *cacheItem) int32 { return i.ID }
+	cache := NewReadCache()
+
+	item, err := CachedFind(cache, "item", list, getID, func(i *cacheItem) bool { return i.ID > 1 })
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), item.ID)
+
+	item, err = CachedFind(cache, "item", list, getID, func(i *cacheItem) bool { return i.ID > 2 })
+	assert.NoError(t, err)
+	assert.Nil(t, item)
+}
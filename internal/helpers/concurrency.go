@@ -0,0 +1,198 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const ConcurrentChange = "Concurrent Change"
+
+// maskedValue is returned by Sonarr in place of secrets.
+const maskedValue = "********"
+
+type (
+	priorStateKey       struct{}
+	concurrencyCheckKey struct{}
+)
+
+// WithConcurrencyCheck enables the concurrent change check for the updates sent with the context.
+func WithConcurrencyCheck(ctx context.Context) context.Context {
+	return context.WithValue(ctx, concurrencyCheckKey{}, true)
+}
+
+// ConcurrencyCheckEnabled reports whether the updates sent with the context are checked for concurrent changes.
+func ConcurrencyCheckEnabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(concurrencyCheckKey{}).(bool)
+
+	return enabled
+}
+
+// WithPriorState attaches the payload built from the prior state to the update request context.
+func WithPriorState(ctx context.Context, prior any) context.Context {
+	return context.WithValue(ctx, priorStateKey{}, prior)
+}
+
+// ConcurrentChangeError lists the attributes changed outside of Terraform.
+type ConcurrentChangeError struct {
+	Attributes []string
+}
+
+func (e *ConcurrentChangeError) Error() string {
+	return "the following attributes were changed outside of Terraform: " + strings.Join(e.Attributes, ", ")
+}
+
+// checkPriorState compares the live object with the prior state attached to the context, if any.
+func checkPriorState(ctx context.Context, live map[string]any) error {
+	prior := ctx.Value(priorStateKey{})
+	if prior == nil {
+		return nil
+	}
+
+	body, err := json.Marshal(prior)
+	if err != nil {
+		return nil
+	}
+
+	var state map[string]any
+	if err := decodeJSON(bytes.NewReader(body), &state); err != nil {
+		return nil
+	}
+
+	if changed := ChangedAttributes(live, state); len(changed) > 0 {
+		return &ConcurrentChangeError{Attributes: changed}
+	}
+
+	return nil
+}
+
+// ChangedAttributes returns the attributes of the prior state that differ in the live object.
+// Only values set on both sides are compared, provider fields are compared by name.
+func ChangedAttributes(live, prior map[string]any) []string {
+	changed := []string{}
+
+	for key, value := range prior {
+		if key == "id" {
+			continue
+		}
+
+		if key == "fields" {
+			changed = append(changed, changedFields(live[key], value)...)
+
+			continue
+		}
+
+		if !contains(live[key], value) {
+			changed = append(changed, toSnakeCase(key))
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+func changedFields(live, prior any) []string {
+	liveFields, _ := live.([]any)
+	priorFields, _ := prior.([]any)
+	values := make(map[string]any, len(liveFields))
+	changed := []string{}
+
+	for _, field := range liveFields {
+		if f, ok := field.(map[string]any); ok {
+			values[fmt.Sprint(f["name"])] = f["value"]
+		}
+	}
+
+	for _, field := range priorFields {
+		f, ok := field.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		name := fmt.Sprint(f["name"])
+		if value, found := values[name]; found && !contains(value, f["value"]) {
+			changed = append(changed, toSnakeCase(name))
+		}
+	}
+
+	return changed
+}
+
+// contains checks that the live value includes the prior one.
+// Unset and masked values are not compared, lists are compared regardless of the order.
+func contains(live, prior any) bool {
+	if live == nil || prior == nil || live == maskedValue {
+		return true
+	}
+
+	switch p := prior.(type) {
+	case map[string]any:
+		l, ok := live.(map[string]any)
+		if !ok {
+			return false
+		}
+
+		for key, value := range p {
+			if !contains(l[key], value) {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		l, ok := live.([]any)
+		if !ok {
+			return false
+		}
+
+		return containsAll(l, p) && (hasObjects(p) || containsAll(p, l))
+	case json.Number:
+		l, ok := live.(json.Number)
+		if !ok {
+			return false
+		}
+
+		lf, _ := l.Float64()
+		pf, _ := p.Float64()
+
+		return lf == pf
+	default:
+		return live == prior
+	}
+}
+
+// containsAll checks that every item has a match in the list.
+func containsAll(list, items []any) bool {
+	for _, item := range items {
+		found := false
+
+		for _, candidate := range list {
+			if contains(candidate, item) {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hasObjects is true for lists of objects, where the live items can hold more values than the prior ones.
+func hasObjects(list []any) bool {
+	for _, item := range list {
+		if _, ok := item.(map[string]any); ok {
+			return true
+		}
+	}
+
+	return false
+}
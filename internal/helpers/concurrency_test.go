@@ -0,0 +1,142 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangedAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		live     string
+		prior    string
+		expected []string
+	}{
+		"unchanged": {
+			live:     `{"id":1,"name":"test","enable":true,"statistics":{"count":2}}`,
+			prior:    `{"id":1,"name":"test","enable":true}`,
+			expected: []string{},
+		},
+		"changed": {
+			live:     `{"id":1,"name":"other","qualityProfileId":2}`,
+			prior:    `{"id":1,"name":"test","qualityProfileId":1}`,
+			expected: []string{"name", "quality_profile_id"},
+		},
+		"tags_order": {
+			live:     `{"tags":[2,1]}`,
+			prior:    `{"tags":[1,2]}`,
+			expected: []string{},
+		},
+		"tags_added": {
+			live:     `{"tags":[1,2,3]}`,
+			prior:    `{"tags":[1,2]}`,
+			expected: []string{"tags"},
+		},
+		"fields": {
+			live:     `{"fields":[{"name":"host","value":"b"},{"name":"apiKey","value":"********"},{"name":"newField","value":1}]}`,
+			prior:    `{"fields":[{"name":"host","value":"a"},{"name":"apiKey","value":"secret"}]}`,
+			expected: []string{"host"},
+		},
+		"nested_list": {
+			live:     `{"seasons":[{"seasonNumber":1,"monitored":true,"statistics":{}},{"seasonNumber":2,"monitored":false}]}`,
+			prior:    `{"seasons":[{"seasonNumber":1,"monitored":true}]}`,
+			expected: []string{},
+		},
+		"unset": {
+			live:     `{"addOptions":null}`,
+			prior:    `{"addOptions":{"monitor":"all"}}`,
+			expected: []string{},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var live, prior map[string]any
+
+			assert.NoError(t, decodeJSON(strings.NewReader(test.live), &live))
+			assert.NoError(t, decodeJSON(strings.NewReader(test.prior), &prior))
+			assert.Equal(t, test.expected, ChangedAttributes(live, prior))
+		})
+	}
+}
+
+func TestMergeTransportConcurrentChanges(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prior   map[string]any
+		check   bool
+		updated bool
+	}{
+		"changed": {
+			prior: map[string]any{"name": "test"},
+			check: true,
+		},
+		"unchanged": {
+			prior:   map[string]any{"name": "live"},
+			check:   true,
+			updated: true,
+		},
+		"disabled": {
+			prior:   map[string]any{"name": "test"},
+			updated: true,
+		},
+		"no_prior": {
+			check:   true,
+			updated: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			updated := false
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_ = json.NewEncoder(w).Encode(map[string]any{"id": 1, "name": "live"})
+
+					return
+				}
+
+				updated = true
+			}))
+			t.Cleanup(server.Close)
+
+			ctx := context.Background()
+			if test.check {
+				ctx = WithConcurrencyCheck(ctx)
+			}
+
+			if test.prior != nil {
+				ctx = WithPriorState(ctx, test.prior)
+			}
+
+			req, _ := http.NewRequestWithContext(ctx, http.MethodPut, server.URL+"/api/v3/tag/1", strings.NewReader(`{"id":1,"name":"new"}`))
+			transport := &MergeTransport{Next: http.DefaultTransport}
+
+			resp, err := (&http.Client{Transport: transport}).Do(req)
+			if err == nil {
+				resp.Body.Close()
+			}
+
+			var changed *ConcurrentChangeError
+
+			assert.Equal(t, !test.updated, errors.As(err, &changed))
+			assert.Equal(t, test.updated, updated)
+		})
+	}
+}
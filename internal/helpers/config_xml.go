@@ -0,0 +1,51 @@
+package helpers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ConfigXML describes the settings stored by Sonarr in its config.xml.
+type ConfigXML struct {
+	APIKey    string `xml:"ApiKey"`
+	URLBase   string `xml:"UrlBase"`
+	Port      int    `xml:"Port"`
+	SslPort   int    `xml:"SslPort"`
+	EnableSsl bool   `xml:"EnableSsl"`
+}
+
+// ReadConfigXML parses the Sonarr config.xml file.
+func ReadConfigXML(path string) (*ConfigXML, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &ConfigXML{}
+	if err := xml.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("cannot parse '%s': %w", path, err)
+	}
+
+	return config, nil
+}
+
+// URL builds the local Sonarr URL from the config.xml settings.
+func (c *ConfigXML) URL() string {
+	if c.Port == 0 {
+		return ""
+	}
+
+	protocol, port := "http", c.Port
+	if c.EnableSsl && c.SslPort != 0 {
+		protocol, port = "https", c.SslPort
+	}
+
+	base := strings.Trim(c.URLBase, "/")
+	if base != "" {
+		base = "/" + base
+	}
+
+	return fmt.Sprintf("%s://localhost:%d%s", protocol, port, base)
+}
@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadConfigXML(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content string
+		key     string
+		url     string
+	}{
+		"default": {
+			content: `<Config><Port>8989</Port><SslPort>9898</SslPort><EnableSsl>False</EnableSsl><ApiKey>abc123</ApiKey><UrlBase></UrlBase></Config>`,
+			key:     "abc123",
+			url:     "http://localhost:8989",
+		},
+		"ssl_base": {
+			content: `<Config><Port>8989</Port><SslPort>9898</SslPort><EnableSsl>true</EnableSsl><ApiKey>abc123</ApiKey><UrlBase>/sonarr/</UrlBase></Config>`,
+			key:     "abc123",
+			url:     "https://localhost:9898/sonarr",
+		},
+		"key_only": {
+			content: `<Config><ApiKey>abc123</ApiKey></Config>`,
+			key:     "abc123",
+			url:     "",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "config.xml")
+			assert.NoError(t, os.WriteFile(path, []byte(test.content), 0o600))
+
+			config, err := ReadConfigXML(path)
+			assert.NoError(t, err)
+			assert.Equal(t, test.key, config.APIKey)
+			assert.Equal(t, test.url, config.URL())
+		})
+	}
+}
+
+func TestReadConfigXMLError(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.xml")
+
+	_, err := ReadConfigXML(path)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(path, []byte("<Config>"), 0o600))
+
+	_, err = ReadConfigXML(path)
+	assert.ErrorContains(t, err, "cannot parse")
+}
@@ -1,9 +1,16 @@
 package helpers
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // define constant for error management.
@@ -16,15 +23,23 @@ const (
 	ClientError                       = "Client Error"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
+	InvalidReference                  = "Invalid Reference"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
 )
 
+// ErrNotFound is returned when an object is missing from a cached list.
+var ErrNotFound = errors.New(strconv.Itoa(http.StatusNotFound) + " " + http.StatusText(http.StatusNotFound))
+
 func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
 
+func ParseReferenceError(kind, field, search string) string {
+	return fmt.Sprintf("Unable to find %s, got error: referenced object not found: no %s with %s '%s'", kind, kind, field, search)
+}
+
 func WrongClient(clientType string, providerData interface{}) string {
 	return fmt.Sprintf("Expected %s, got: %T. Please report this issue to the provider developers.", clientType, providerData)
 }
@@ -36,3 +51,25 @@ func ParseClientError(action, name string, err error) string {
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// IsNotFoundError checks if the error is a not found response from Sonarr.
+func IsNotFoundError(err error) bool {
+	if e, ok := err.(*sonarr.GenericOpenAPIError); ok {
+		return strings.HasPrefix(e.Error(), strconv.Itoa(http.StatusNotFound))
+	}
+
+	return errors.Is(err, ErrNotFound)
+}
+
+// HandleReadError removes the resource from state if it has been deleted outside of terraform,
+// otherwise it reports the client error.
+func HandleReadError(ctx context.Context, name string, err error, resp *resource.ReadResponse) {
+	if IsNotFoundError(err) {
+		tflog.Warn(ctx, name+" not found, removing it from state")
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.AddError(ClientError, ParseClientError(Read, name, err))
+}
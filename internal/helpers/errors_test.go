@@ -1,7 +1,10 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -66,6 +69,32 @@ func TestParseNotFoundError(t *testing.T) {
 	}
 }
 
+func TestParseReferenceError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		kind     string
+		field    string
+		search   string
+		expected string
+	}{
+		"generic": {
+			kind:     "tag",
+			field:    "id",
+			search:   "1",
+			expected: "Unable to find tag, got error: referenced object not found: no tag with id '1'",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ParseReferenceError(test.kind, test.field, test.search))
+		})
+	}
+}
+
 func TestWrongClient(t *testing.T) {
 	t.Parallel()
 
@@ -89,3 +118,45 @@ func TestWrongClient(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		expected bool
+	}{
+		"not_found": {
+			status:   http.StatusNotFound,
+			expected: true,
+		},
+		"unauthorized": {
+			status:   http.StatusUnauthorized,
+			expected: false,
+		},
+		"server_error": {
+			status:   http.StatusInternalServerError,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			config := sonarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			_, _, err := sonarr.NewAPIClient(config).TagAPI.GetTagById(context.Background(), 1).Execute()
+
+			assert.Equal(t, test.expected, IsNotFoundError(err))
+		})
+	}
+
+	assert.False(t, IsNotFoundError(errors.New("404 other error")))
+}
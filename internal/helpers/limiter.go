@@ -0,0 +1,133 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Limiter caps the concurrency and the rate of the requests sent to Sonarr.
+// It is shared by all the resources and data sources of a provider instance.
+type Limiter struct {
+	next     time.Time
+	slots    chan struct{}
+	writes   chan struct{}
+	interval time.Duration
+	mu       sync.Mutex
+}
+
+// NewLimiter returns a limiter, zero values mean no limit.
+func NewLimiter(maxConcurrent int, perSecond float64, serializeWrites bool) *Limiter {
+	limiter := &Limiter{}
+
+	if maxConcurrent > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrent)
+	}
+
+	if perSecond > 0 {
+		limiter.interval = time.Duration(float64(time.Second) / perSecond)
+	}
+
+	if serializeWrites {
+		limiter.writes = make(chan struct{}, 1)
+	}
+
+	return limiter
+}
+
+// Acquire waits for the request to be allowed, the returned function must be called once it is done.
+func (l *Limiter) Acquire(ctx context.Context, write bool) (func(), error) {
+	var releases []chan struct{}
+
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			<-releases[i]
+		}
+	}
+
+	if write && l.writes != nil {
+		if err := acquireSlot(ctx, l.writes); err != nil {
+			return nil, err
+		}
+
+		releases = append(releases, l.writes)
+	}
+
+	if l.slots != nil {
+		if err := acquireSlot(ctx, l.slots); err != nil {
+			release()
+
+			return nil, err
+		}
+
+		releases = append(releases, l.slots)
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// wait reserves the next request time slot and waits for it.
+func (l *Limiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+
+	if l.next.Before(now) {
+		l.next = now
+	}
+
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func acquireSlot(ctx context.Context, slots chan struct{}) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case slots <- struct{}{}:
+		return nil
+	}
+}
+
+// LimitTransport applies the limiter to each request.
+type LimitTransport struct {
+	Next    http.RoundTripper
+	Limiter *Limiter
+}
+
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	write := req.Method != http.MethodGet && req.Method != http.MethodHead && req.Method != http.MethodOptions
+
+	release, err := t.Limiter.Acquire(req.Context(), write)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return t.Next.RoundTrip(req)
+}
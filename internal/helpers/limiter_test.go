@@ -0,0 +1,130 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method          string
+		maxConcurrent   int
+		serializeWrites bool
+		expected        int32
+	}{
+		"parallel_reads": {
+			method:          http.MethodGet,
+			serializeWrites: true,
+			expected:        5,
+		},
+		"capped_reads": {
+			method:        http.MethodGet,
+			maxConcurrent: 2,
+			expected:      2,
+		},
+		"serialized_writes": {
+			method:          http.MethodPut,
+			serializeWrites: true,
+			expected:        1,
+		},
+		"parallel_writes": {
+			method:   http.MethodPut,
+			expected: 5,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var running, peak atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				current := running.Add(1)
+				for {
+					old := peak.Load()
+					if current <= old || peak.CompareAndSwap(old, current) {
+						break
+					}
+				}
+
+				time.Sleep(50 * time.Millisecond)
+				running.Add(-1)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &LimitTransport{
+				Next:    http.DefaultTransport,
+				Limiter: NewLimiter(test.maxConcurrent, 0, test.serializeWrites),
+			}}
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					req, _ := http.NewRequest(test.method, server.URL, nil)
+
+					resp, err := client.Do(req)
+					if assert.NoError(t, err) {
+						resp.Body.Close()
+					}
+				}()
+			}
+
+			wg.Wait()
+			assert.LessOrEqual(t, peak.Load(), test.expected)
+
+			if test.expected > 1 {
+				assert.Greater(t, peak.Load(), int32(1))
+			}
+		})
+	}
+}
+
+func TestLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(0, 20, false)
+	start := time.Now()
+
+	for i := 0; i < 5; i++ {
+		release, err := limiter.Acquire(context.Background(), false)
+		assert.NoError(t, err)
+		release()
+	}
+
+	// first request is immediate, the other four wait 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestLimiterCancel(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewLimiter(1, 0, false)
+
+	release, err := limiter.Acquire(context.Background(), false)
+	assert.NoError(t, err)
+
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = limiter.Acquire(ctx, false)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,55 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrLoginFailed is returned when Sonarr rejects the credentials.
+var ErrLoginFailed = errors.New("login failed, check username and password")
+
+// Login authenticates against the Sonarr forms login, the session cookie is kept in the client jar.
+func Login(ctx context.Context, client *http.Client, baseURL, username, password string) error {
+	if client.Jar == nil {
+		return errors.New("login needs a client with a cookie jar")
+	}
+
+	form := url.Values{
+		"username":   {username},
+		"password":   {password},
+		"rememberMe": {"on"},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, baseURL+"/login?returnUrl=%2F", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	// Sonarr answers with a redirect, failures point back to the login page
+	noRedirect := *client
+	noRedirect.CheckRedirect = func(_ *http.Request, _ []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := noRedirect.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("login returned %s", resp.Status)
+	}
+
+	if strings.Contains(resp.Header.Get("Location"), "loginFailed") || len(client.Jar.Cookies(req.URL)) == 0 {
+		return ErrLoginFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogin(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/sonarr/login" || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if r.FormValue("username") == "admin" && r.FormValue("password") == "secret" {
+			http.SetCookie(w, &http.Cookie{Name: "SonarrAuth", Value: "session", Path: "/"})
+			http.Redirect(w, r, "/sonarr/", http.StatusFound)
+
+			return
+		}
+
+		http.Redirect(w, r, "/sonarr/login?returnUrl=%2F&loginFailed=true", http.StatusFound)
+	}))
+	t.Cleanup(server.Close)
+
+	tests := map[string]struct {
+		base     string
+		password string
+		err      string
+	}{
+		"success": {
+			base:     "/sonarr",
+			password: "secret",
+		},
+		"wrong_password": {
+			base:     "/sonarr",
+			password: "wrong",
+			err:      ErrLoginFailed.Error(),
+		},
+		"wrong_base": {
+			base:     "",
+			password: "secret",
+			err:      "login returned 404 Not Found",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			jar, _ := cookiejar.New(nil)
+			client := &http.Client{Jar: jar}
+
+			err := Login(context.Background(), client, server.URL+test.base, "admin", test.password)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
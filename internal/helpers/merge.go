@@ -0,0 +1,257 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+)
+
+// MergeTransport turns every update of a single object into a merge with the live object.
+// The current object is fetched before the PUT and the request values are applied on top of it,
+// so that values not managed by the provider, even the ones unknown to the client, are kept.
+// Attributes cleared in the configuration are removed from the live object instead.
+// When the concurrency check is enabled on the request context,
+// the update fails if the live object no longer matches the prior state.
+type MergeTransport struct {
+	Next http.RoundTripper
+}
+
+type clearedKey struct{}
+
+// cleared holds the API names of the attributes unset in the configuration.
+type cleared struct {
+	keys   map[string]bool
+	fields map[string]bool
+}
+
+// WithClearedAttributes marks the attributes unset in the configuration,
+// so that the update does not keep their live value.
+func WithClearedAttributes(ctx context.Context, attributes []string) context.Context {
+	c := cleared{
+		keys:   make(map[string]bool, len(attributes)),
+		fields: make(map[string]bool, len(attributes)),
+	}
+
+	for _, attribute := range attributes {
+		name := toCamelCase(attribute)
+		c.keys[name] = true
+
+		// Renamed fields, e.g. field_tags, must not be cleared by the top level attribute
+		if selectTFName(name) == name {
+			c.fields[selectAPIName(name)] = true
+		}
+	}
+
+	return context.WithValue(ctx, clearedKey{}, c)
+}
+
+func clearedAttributes(ctx context.Context) cleared {
+	c, _ := ctx.Value(clearedKey{}).(cleared)
+
+	return c
+}
+
+func (t *MergeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Only updates addressed by ID, bulk endpoints are sent as they are
+	if req.Method != http.MethodPut || req.Body == nil {
+		return t.Next.RoundTrip(req)
+	}
+
+	if _, err := strconv.Atoi(path.Base(req.URL.Path)); err != nil {
+		return t.Next.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	merged, ok, err := t.merge(req, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		body = merged
+	}
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return t.Next.RoundTrip(req)
+}
+
+// merge fetches the live object, any failure falls back to the original request.
+// Only concurrent changes are reported as errors.
+func (t *MergeTransport) merge(req *http.Request, body []byte) ([]byte, bool, error) {
+	var update map[string]any
+	if err := decodeJSON(bytes.NewReader(body), &update); err != nil {
+		return nil, false, nil
+	}
+
+	get, err := http.NewRequestWithContext(req.Context(), http.MethodGet, req.URL.String(), nil)
+	if err != nil {
+		return nil, false, nil
+	}
+
+	get.Header = req.Header.Clone()
+	get.Header.Del("Content-Type")
+
+	resp, err := t.Next.RoundTrip(get)
+	if err != nil {
+		return nil, false, nil
+	}
+	defer resp.Body.Close()
+
+	var live map[string]any
+	if resp.StatusCode != http.StatusOK || decodeJSON(resp.Body, &live) != nil {
+		return nil, false, nil
+	}
+
+	if ConcurrencyCheckEnabled(req.Context()) {
+		if err := checkPriorState(req.Context(), live); err != nil {
+			return nil, false, err
+		}
+	}
+
+	c := clearedAttributes(req.Context())
+	clearObject(live, update, c.keys, c.fields)
+
+	merged, err := json.Marshal(MergeObject(live, update))
+	if err != nil {
+		return nil, false, nil
+	}
+
+	return merged, true, nil
+}
+
+// decodeJSON keeps numbers as they are to avoid float rounding.
+func decodeJSON(r io.Reader, v any) error {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+
+	return decoder.Decode(v)
+}
+
+// MergeObject applies the update values on top of the live object.
+// Nested objects are merged recursively, lists are replaced
+// except for provider fields that are merged by name.
+func MergeObject(live, update map[string]any) map[string]any {
+	for key, value := range update {
+		switch v := value.(type) {
+		case map[string]any:
+			if current, ok := live[key].(map[string]any); ok {
+				live[key] = MergeObject(current, v)
+
+				continue
+			}
+		case []any:
+			if current, ok := live[key].([]any); ok && key == "fields" {
+				live[key] = mergeFields(current, v)
+
+				continue
+			}
+		}
+
+		live[key] = value
+	}
+
+	return live
+}
+
+// clearObject removes from the live object the cleared values missing from the update.
+// Lists are emptied rather than removed.
+func clearObject(live, update map[string]any, keys, fields map[string]bool) {
+	for key, value := range live {
+		if key == "fields" {
+			live[key] = clearFields(value, update[key], fields)
+
+			continue
+		}
+
+		if _, found := update[key]; found || !keys[key] {
+			continue
+		}
+
+		if _, isList := value.([]any); isList {
+			live[key] = []any{}
+
+			continue
+		}
+
+		delete(live, key)
+	}
+}
+
+// clearFields removes the cleared provider fields missing from the update.
+func clearFields(live, update any, names map[string]bool) any {
+	liveFields, ok := live.([]any)
+	if !ok || len(names) == 0 {
+		return live
+	}
+
+	sent := make(map[string]bool)
+
+	if updateFields, ok := update.([]any); ok {
+		for _, field := range updateFields {
+			if f, ok := field.(map[string]any); ok {
+				if name, ok := f["name"].(string); ok {
+					sent[name] = true
+				}
+			}
+		}
+	}
+
+	kept := make([]any, 0, len(liveFields))
+
+	for _, field := range liveFields {
+		if f, ok := field.(map[string]any); ok {
+			if name, ok := f["name"].(string); ok && names[name] && !sent[name] {
+				continue
+			}
+		}
+
+		kept = append(kept, field)
+	}
+
+	return kept
+}
+
+func mergeFields(live, update []any) []any {
+	index := make(map[string]int, len(live))
+
+	for i, field := range live {
+		if f, ok := field.(map[string]any); ok {
+			if name, ok := f["name"].(string); ok {
+				index[name] = i
+			}
+		}
+	}
+
+	for _, field := range update {
+		f, ok := field.(map[string]any)
+		name, named := f["name"].(string)
+
+		if i, found := index[name]; ok && named && found {
+			if current, ok := live[i].(map[string]any); ok {
+				live[i] = MergeObject(current, f)
+
+				continue
+			}
+		}
+
+		live = append(live, field)
+	}
+
+	return live
+}
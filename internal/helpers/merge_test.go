@@ -0,0 +1,197 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeObject(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		live     string
+		update   string
+		expected string
+	}{
+		"keep_unmanaged": {
+			live:     `{"id":1,"title":"old","statistics":{"seasonCount":2}}`,
+			update:   `{"id":1,"title":"new"}`,
+			expected: `{"id":1,"title":"new","statistics":{"seasonCount":2}}`,
+		},
+		"nested": {
+			live:     `{"addOptions":{"monitor":"all","searchForMissingEpisodes":true}}`,
+			update:   `{"addOptions":{"monitor":"none"}}`,
+			expected: `{"addOptions":{"monitor":"none","searchForMissingEpisodes":true}}`,
+		},
+		"replace_list": {
+			live:     `{"tags":[1,2,3]}`,
+			update:   `{"tags":[4]}`,
+			expected: `{"tags":[4]}`,
+		},
+		"fields": {
+			live:     `{"fields":[{"name":"host","value":"a","label":"Host"},{"name":"newField","value":true}]}`,
+			update:   `{"fields":[{"name":"host","value":"b"},{"name":"port","value":80}]}`,
+			expected: `{"fields":[{"name":"host","value":"b","label":"Host"},{"name":"newField","value":true},{"name":"port","value":80}]}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var live, update map[string]any
+
+			assert.NoError(t, json.Unmarshal([]byte(test.live), &live))
+			assert.NoError(t, json.Unmarshal([]byte(test.update), &update))
+
+			merged, err := json.Marshal(MergeObject(live, update))
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(merged))
+		})
+	}
+}
+
+func TestMergeTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path     string
+		status   int
+		expected string
+	}{
+		"merged": {
+			path:     "/api/v3/series/1",
+			status:   http.StatusOK,
+			expected: `{"id":1,"monitored":true,"alternateTitles":[{"title":"alt"}]}`,
+		},
+		"bulk": {
+			path:     "/api/v3/series/editor",
+			status:   http.StatusOK,
+			expected: `{"id":1,"monitored":true}`,
+		},
+		"live_not_found": {
+			path:     "/api/v3/series/2",
+			status:   http.StatusNotFound,
+			expected: `{"id":1,"monitored":true}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var received string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(`{"id":1,"monitored":false,"alternateTitles":[{"title":"alt"}]}`))
+
+					return
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				received = string(body)
+			}))
+			t.Cleanup(server.Close)
+
+			req, _ := http.NewRequest(http.MethodPut, server.URL+test.path, strings.NewReader(`{"id":1,"monitored":true}`))
+			req.Header.Set("X-Api-Key", "key")
+
+			resp, err := (&http.Client{Transport: &MergeTransport{Next: http.DefaultTransport}}).Do(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+
+			assert.JSONEq(t, test.expected, received)
+		})
+	}
+}
+
+func TestMergeTransportCleared(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		live     string
+		update   string
+		cleared  []string
+		expected string
+	}{
+		"keep_unknown": {
+			live:     `{"id":1,"name":"test","apiKey":"old","newKey":true}`,
+			update:   `{"id":1,"name":"test"}`,
+			expected: `{"id":1,"name":"test","apiKey":"old","newKey":true}`,
+		},
+		"clear_key": {
+			live:     `{"id":1,"name":"test","apiKey":"old","newKey":true}`,
+			update:   `{"id":1,"name":"test"}`,
+			cleared:  []string{"api_key"},
+			expected: `{"id":1,"name":"test","newKey":true}`,
+		},
+		"clear_list": {
+			live:     `{"id":1,"tags":[1,2]}`,
+			update:   `{"id":1}`,
+			cleared:  []string{"tags"},
+			expected: `{"id":1,"tags":[]}`,
+		},
+		"clear_field": {
+			live:     `{"id":1,"fields":[{"name":"deviceNames","value":"a"},{"name":"apiKey","value":"old"},{"name":"newField","value":1}]}`,
+			update:   `{"id":1,"fields":[{"name":"apiKey","value":"new"}]}`,
+			cleared:  []string{"device_names"},
+			expected: `{"id":1,"fields":[{"name":"apiKey","value":"new"},{"name":"newField","value":1}]}`,
+		},
+		"clear_all_fields": {
+			live:     `{"id":1,"fields":[{"name":"deviceNames","value":"a"},{"name":"newField","value":1}]}`,
+			update:   `{"id":1}`,
+			cleared:  []string{"device_names"},
+			expected: `{"id":1,"fields":[{"name":"newField","value":1}]}`,
+		},
+		"renamed_field": {
+			live:     `{"id":1,"tags":[1],"fields":[{"name":"tags","value":[2]},{"name":"seedCriteria.seedTime","value":10}]}`,
+			update:   `{"id":1}`,
+			cleared:  []string{"tags", "seed_time"},
+			expected: `{"id":1,"tags":[],"fields":[{"name":"tags","value":[2]}]}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var received string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodGet {
+					_, _ = w.Write([]byte(test.live))
+
+					return
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				received = string(body)
+			}))
+			t.Cleanup(server.Close)
+
+			ctx := WithClearedAttributes(context.Background(), test.cleared)
+			req, _ := http.NewRequestWithContext(ctx, http.MethodPut, server.URL+"/api/v3/notification/1", strings.NewReader(test.update))
+
+			resp, err := (&http.Client{Transport: &MergeTransport{Next: http.DefaultTransport}}).Do(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+
+			assert.JSONEq(t, test.expected, received)
+		})
+	}
+}
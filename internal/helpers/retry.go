@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// RetryTransport retries idempotent requests failing with a transient error.
+type RetryTransport struct {
+	Next       http.RoundTripper
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+// idempotent methods are safe to be sent more than once.
+var idempotent = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// transientStatus are the responses Sonarr returns while it is busy or restarting.
+var transientStatus = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+type noRetryKey struct{}
+
+// WithoutRetry disables the retries of the requests sent with the context,
+// e.g. when the caller polls on its own schedule.
+func WithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if noRetry, _ := req.Context().Value(noRetryKey{}).(bool); noRetry {
+		return t.Next.RoundTrip(req)
+	}
+
+	if !idempotent[req.Method] || t.MaxRetries <= 0 {
+		return t.Next.RoundTrip(req)
+	}
+
+	// a body that cannot be read again is sent once
+	rewindable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		// the caller request must not be modified, each attempt sends a copy
+		attemptReq := req.Clone(req.Context())
+
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq.Body = body
+		}
+
+		resp, err := t.Next.RoundTrip(attemptReq)
+
+		reason := t.retryReason(resp, err)
+		if reason == "" || attempt >= t.MaxRetries || !rewindable {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		tflog.Warn(req.Context(), "retrying "+req.Method+" "+req.URL.Path+" after "+wait.String()+": "+reason, map[string]interface{}{
+			"attempt":     attempt + 1,
+			"max_retries": t.MaxRetries,
+		})
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retryReason returns why the request should be retried, empty if it should not.
+func (t *RetryTransport) retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		if isTransientError(err) {
+			return err.Error()
+		}
+
+		return ""
+	}
+
+	if transientStatus[resp.StatusCode] {
+		return resp.Status
+	}
+
+	if resp.StatusCode == http.StatusInternalServerError {
+		// Sonarr database can be locked during housekeeping
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		if readErr == nil && strings.Contains(strings.ToLower(string(body)), "database is locked") {
+			return resp.Status + " database is locked"
+		}
+	}
+
+	return ""
+}
+
+// backoff doubles the wait at each attempt within the limits, honoring Retry-After.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.WaitMax)
+		}
+	}
+
+	wait := t.WaitMin << attempt
+	if wait <= 0 || wait > t.WaitMax {
+		return t.WaitMax
+	}
+
+	return wait
+}
+
+// isTransientError reports whether a network error can succeed on a later attempt.
+// TLS and certificate failures, as well as canceled requests, are never retried.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
@@ -0,0 +1,194 @@
+package helpers
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method   string
+		status   int
+		body     string
+		noRetry  bool
+		expected int32
+	}{
+		"get_unavailable": {
+			method:   http.MethodGet,
+			status:   http.StatusServiceUnavailable,
+			expected: 3,
+		},
+		"put_database_locked": {
+			method:   http.MethodPut,
+			status:   http.StatusInternalServerError,
+			body:     "SQLite Error 5: 'database is locked'",
+			expected: 3,
+		},
+		"get_internal_error": {
+			method:   http.MethodGet,
+			status:   http.StatusInternalServerError,
+			body:     "other error",
+			expected: 1,
+		},
+		"post_unavailable": {
+			method:   http.MethodPost,
+			status:   http.StatusServiceUnavailable,
+			expected: 1,
+		},
+		"get_without_retry": {
+			method:   http.MethodGet,
+			status:   http.StatusServiceUnavailable,
+			noRetry:  true,
+			expected: 1,
+		},
+		"get_not_found": {
+			method:   http.MethodGet,
+			status:   http.StatusNotFound,
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+
+				body, _ := io.ReadAll(r.Body)
+				assert.Equal(t, "payload", string(body))
+
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{
+				Next:       http.DefaultTransport,
+				MaxRetries: 2,
+				WaitMin:    time.Millisecond,
+				WaitMax:    time.Millisecond,
+			}}
+
+			ctx := context.Background()
+			if test.noRetry {
+				ctx = WithoutRetry(ctx)
+			}
+
+			req, err := http.NewRequestWithContext(ctx, test.method, server.URL, strings.NewReader("payload"))
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+
+			body, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			assert.Equal(t, test.body, string(body))
+			assert.Equal(t, test.expected, calls.Load())
+		})
+	}
+}
+
+// timeoutError is a network error reporting a timeout.
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+// roundTripFunc adapts a function to a round tripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransportErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		expected int
+	}{
+		"connection_refused": {
+			err:      &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED},
+			expected: 3,
+		},
+		"timeout": {
+			err:      timeoutError{},
+			expected: 3,
+		},
+		"unknown_authority": {
+			err:      &tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}},
+			expected: 1,
+		},
+		"canceled": {
+			err:      context.Canceled,
+			expected: 1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			transport := &RetryTransport{
+				Next: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+					calls++
+
+					body, _ := io.ReadAll(r.Body)
+					assert.Equal(t, "payload", string(body))
+
+					return nil, test.err
+				}),
+				MaxRetries: 2,
+				WaitMin:    time.Millisecond,
+				WaitMax:    time.Millisecond,
+			}
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPut, "http://localhost", strings.NewReader("payload"))
+			assert.NoError(t, err)
+
+			body := req.Body
+
+			_, err = transport.RoundTrip(req)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, calls)
+			// the caller request is left untouched
+			assert.Equal(t, body, req.Body)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	transport := &RetryTransport{WaitMin: time.Second, WaitMax: 5 * time.Second}
+
+	assert.Equal(t, time.Second, transport.backoff(0, nil))
+	assert.Equal(t, 4*time.Second, transport.backoff(2, nil))
+	assert.Equal(t, 5*time.Second, transport.backoff(3, nil))
+	assert.Equal(t, 5*time.Second, transport.backoff(100, nil))
+	assert.Equal(t, 2*time.Second, transport.backoff(0, &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,3 +33,55 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// Natural keys accepted by ImportStateWithKey.
+const (
+	NameImportKey  = "name"
+	LabelImportKey = "label"
+	TvdbImportKey  = "tvdb"
+	PathImportKey  = "path"
+)
+
+// ImportStateWithKey extends ImportStatePassthroughIntID to also accept a `<key>:<value>` identifier.
+// The value is resolved to the ID of the only listed object it matches.
+func ImportStateWithKey[T any](ctx context.Context, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse, name, key string, list func() ([]T, error), match func(*T, string) bool, getID func(*T) int32) {
+	if _, err := strconv.Atoi(req.ID); err == nil {
+		ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+
+		return
+	}
+
+	prefix, value, found := strings.Cut(req.ID, ":")
+	if !found || prefix != key || value == "" {
+		resp.Diagnostics.AddError(
+			UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: ID or %s:<value>. Got: %s", key, req.ID),
+		)
+
+		return
+	}
+
+	items, err := list()
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(List, name, err))
+
+		return
+	}
+
+	var ids []int32
+
+	for i := range items {
+		if match(&items[i], value) {
+			ids = append(ids, getID(&items[i]))
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(ResourceError, fmt.Sprintf("Unable to import %s, no %s with %s '%s'", name, name, key, value))
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, int64(ids[0]))...)
+	default:
+		resp.Diagnostics.AddError(ResourceError, fmt.Sprintf("Unable to import %s, multiple %s with %s '%s', import by ID instead", name, name, key, value))
+	}
+}
@@ -0,0 +1,95 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+type importItem struct {
+	Name string
+	ID   int32
+}
+
+func TestImportStateWithKey(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		id       string
+		listErr  error
+		expected int64
+		err      string
+	}{
+		"id": {
+			id:       "7",
+			expected: 7,
+		},
+		"name": {
+			id:       "name:Test",
+			expected: 2,
+		},
+		"missing": {
+			id:  "name:other",
+			err: "no item with name 'other'",
+		},
+		"multiple": {
+			id:  "name:duplicate",
+			err: "multiple item with name 'duplicate'",
+		},
+		"wrong_key": {
+			id:  "label:test",
+			err: "Expected import identifier with format: ID or name:<value>",
+		},
+		"list_error": {
+			id:      "name:test",
+			listErr: errors.New("list error"),
+			err:     "Unable to list item",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			testSchema := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{Computed: true},
+				},
+			}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil)},
+			}
+			list := func() ([]importItem, error) {
+				return []importItem{{ID: 1, Name: "duplicate"}, {ID: 2, Name: "test"}, {ID: 3, Name: "duplicate"}}, test.listErr
+			}
+
+			ImportStateWithKey(ctx, path.Root("id"), resource.ImportStateRequest{ID: test.id}, resp, "item", NameImportKey, list,
+				func(i *importItem, value string) bool { return strings.EqualFold(i.Name, value) },
+				func(i *importItem) int32 { return i.ID },
+			)
+
+			if test.err != "" {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics[0].Detail(), test.err)
+
+				return
+			}
+
+			var id int64
+
+			assert.False(t, resp.Diagnostics.HasError())
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
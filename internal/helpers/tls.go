@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+)
+
+// TLSOptions configures how the provider verifies Sonarr and authenticates to it.
+type TLSOptions struct {
+	// CACertificate is a PEM encoded CA bundle added to the system pool.
+	CACertificate string
+	// ClientCertificate and ClientKey are a PEM encoded pair used for mutual TLS.
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// NewTLSTransport returns a transport using the given TLS options.
+func NewTLSTransport(options TLSOptions) (*http.Transport, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		//nolint:gosec // explicitly requested by the user
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if options.CACertificate != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM([]byte(options.CACertificate)) {
+			return nil, errors.New("no valid PEM certificate found in CA certificate")
+		}
+
+		config.RootCAs = pool
+	}
+
+	if (options.ClientCertificate == "") != (options.ClientKey == "") {
+		return nil, errors.New("client certificate and client key must be set together")
+	}
+
+	if options.ClientCertificate != "" {
+		cert, err := tls.X509KeyPair([]byte(options.ClientCertificate), []byte(options.ClientKey))
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	transport, _ := http.DefaultTransport.(*http.Transport)
+	transport = transport.Clone()
+	transport.TLSClientConfig = config
+
+	return transport, nil
+}
@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testClientCertificate generates a self signed client certificate and key.
+func testClientCertificate(t *testing.T) (*x509.Certificate, string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return cert,
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+func TestNewTLSTransport(t *testing.T) {
+	t.Parallel()
+
+	clientCert, certPEM, keyPEM := testClientCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := map[string]struct {
+		options TLSOptions
+		success bool
+	}{
+		"mutual_tls": {
+			options: TLSOptions{CACertificate: caPEM, ClientCertificate: certPEM, ClientKey: keyPEM},
+			success: true,
+		},
+		"insecure": {
+			options: TLSOptions{InsecureSkipVerify: true, ClientCertificate: certPEM, ClientKey: keyPEM},
+			success: true,
+		},
+		"unknown_ca": {
+			options: TLSOptions{ClientCertificate: certPEM, ClientKey: keyPEM},
+		},
+		"missing_client_certificate": {
+			options: TLSOptions{CACertificate: caPEM},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			transport, err := NewTLSTransport(test.options)
+			assert.NoError(t, err)
+
+			resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if test.success {
+				if assert.NoError(t, err) {
+					resp.Body.Close()
+				}
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestNewTLSTransportError(t *testing.T) {
+	t.Parallel()
+
+	_, certPEM, _ := testClientCertificate(t)
+
+	_, err := NewTLSTransport(TLSOptions{CACertificate: "invalid"})
+	assert.EqualError(t, err, "no valid PEM certificate found in CA certificate")
+
+	_, err = NewTLSTransport(TLSOptions{ClientCertificate: certPEM})
+	assert.EqualError(t, err, "client certificate and client key must be set together")
+}
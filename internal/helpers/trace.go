@@ -0,0 +1,181 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// APISubsystem is the tflog subsystem used to trace Sonarr API calls.
+	APISubsystem = "sonarr_api"
+	// APITraceEnv enables the API tracing, e.g. `TF_LOG_PROVIDER_SONARR_API=TRACE`.
+	APITraceEnv = "TF_LOG_PROVIDER_SONARR_API"
+	// maxTracedBody limits the body size written in logs.
+	maxTracedBody = 16 * 1024
+	redacted      = "***"
+)
+
+var (
+	sensitiveKey     = regexp.MustCompile(`(?i)(api[-_]?key|authorization|cookie|password|passkey|secret|token)`)
+	sensitiveFormKey = regexp.MustCompile(`(?i)((?:api_?key|password|passkey|secret|token)=)[^&\s]*`)
+	// sensitiveField matches the provider fields declared as sensitive in the resource schemas.
+	sensitiveField = regexp.MustCompile(`(?i)(key|password|secret|token|^senderNumber$)`)
+	// sensitiveHeaders are always masked, whatever their name looks like.
+	sensitiveHeaders = map[string]bool{"X-Api-Key": true, "Authorization": true, "Cookie": true, "Set-Cookie": true}
+)
+
+// TraceTransport logs requests and responses through the sonarr_api subsystem, masking secrets.
+type TraceTransport struct {
+	Next http.RoundTripper
+}
+
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	fields := map[string]interface{}{
+		"method":          req.Method,
+		"url":             RedactURL(req.URL),
+		"request_headers": redactHeaders(req.Header),
+	}
+
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(body)
+			fields["request_body"] = RedactBody(content)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.Next.RoundTrip(req)
+	fields["latency"] = time.Since(start).String()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(ctx, APISubsystem, "Sonarr API request failed", fields)
+
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+
+	content, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(content))
+
+	if readErr == nil {
+		fields["response_body"] = RedactBody(content)
+	}
+
+	tflog.SubsystemTrace(ctx, APISubsystem, "Sonarr API request", fields)
+
+	return resp, nil
+}
+
+// RedactURL masks secrets passed as query parameters.
+func RedactURL(u *url.URL) string {
+	redactedURL := *u
+	query := redactedURL.Query()
+
+	for key := range query {
+		if sensitiveKey.MatchString(key) {
+			query.Set(key, redacted)
+		}
+	}
+
+	redactedURL.RawQuery = query.Encode()
+
+	return redactedURL.String()
+}
+
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+
+	for key, values := range headers {
+		if sensitiveHeaders[http.CanonicalHeaderKey(key)] || sensitiveKey.MatchString(key) {
+			result[key] = redacted
+
+			continue
+		}
+
+		result[key] = strings.Join(values, ", ")
+	}
+
+	return result
+}
+
+// RedactBody masks sensitive values of a JSON body.
+// Sonarr marks sensitive provider fields with a privacy other than normal,
+// fields sent by the provider are matched by name.
+func RedactBody(body []byte) string {
+	var data interface{}
+
+	if len(body) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&data); err != nil {
+		return truncate(sensitiveFormKey.ReplaceAllString(string(body), "${1}"+redacted))
+	}
+
+	content, err := json.Marshal(redactValue(data))
+	if err != nil {
+		return ""
+	}
+
+	return truncate(string(content))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if _, ok := v["value"]; ok && isSensitiveField(v) {
+			v["value"] = redacted
+		}
+
+		for key, item := range v {
+			if _, isString := item.(string); isString && sensitiveKey.MatchString(key) {
+				v[key] = redacted
+
+				continue
+			}
+
+			v[key] = redactValue(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+
+	return value
+}
+
+// isSensitiveField reports whether a provider field holds a secret.
+// Request bodies built by the provider carry no privacy, so the field name is checked too.
+func isSensitiveField(field map[string]interface{}) bool {
+	if privacy, ok := field["privacy"].(string); ok && privacy != "normal" {
+		return true
+	}
+
+	name, ok := field["name"].(string)
+
+	return ok && sensitiveField.MatchString(name)
+}
+
+func truncate(content string) string {
+	if len(content) > maxTracedBody {
+		return content[:maxTracedBody] + "...(truncated)"
+	}
+
+	return content
+}
@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"empty": {
+			body:     "",
+			expected: "",
+		},
+		"privacy_fields": {
+			body:     `{"name":"test","fields":[{"name":"host","value":"localhost","privacy":"normal"},{"name":"apiKey","value":"secret1","privacy":"apiKey"},{"name":"password","value":"secret2","privacy":"password"}]}`,
+			expected: `{"fields":[{"name":"host","privacy":"normal","value":"localhost"},{"name":"apiKey","privacy":"apiKey","value":"***"},{"name":"password","privacy":"password","value":"***"}],"name":"test"}`,
+		},
+		"request_fields": {
+			body:     `{"name":"test","fields":[{"name":"host","value":"localhost"},{"name":"apiKey","value":"x"},{"name":"password","value":"y"},{"name":"authToken","value":"z"}]}`,
+			expected: `{"fields":[{"name":"host","value":"localhost"},{"name":"apiKey","value":"***"},{"name":"password","value":"***"},{"name":"authToken","value":"***"}],"name":"test"}`,
+		},
+		"notification_create": {
+			body:     `{"name":"test","implementation":"Pushover","fields":[{"name":"key","value":"a"},{"name":"userKey","value":"b"},{"name":"consumerKey","value":"c"},{"name":"configurationKey","value":"d"},{"name":"senderNumber","value":"e"},{"name":"priority","value":1}]}`,
+			expected: `{"fields":[{"name":"key","value":"***"},{"name":"userKey","value":"***"},{"name":"consumerKey","value":"***"},{"name":"configurationKey","value":"***"},{"name":"senderNumber","value":"***"},{"name":"priority","value":1}],"implementation":"Pushover","name":"test"}`,
+		},
+		"sensitive_keys": {
+			body:     `[{"apiKey":"secret1","password":"secret2","passwordConfirmation":"secret3","id":1}]`,
+			expected: `[{"apiKey":"***","id":1,"password":"***","passwordConfirmation":"***"}]`,
+		},
+		"form": {
+			body:     "username=admin&password=secret",
+			expected: "username=admin&password=***",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, RedactBody([]byte(test.body)))
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := http.Header{}
+	headers.Set("X-Api-Key", "secret1")
+	headers.Set("Cookie", "session=secret2")
+	headers.Set("Set-Cookie", "session=secret3")
+	headers.Set("Authorization", "Bearer secret4")
+	headers.Set("Content-Type", "application/json")
+
+	assert.Equal(t, map[string]string{
+		"X-Api-Key":     "***",
+		"Cookie":        "***",
+		"Set-Cookie":    "***",
+		"Authorization": "***",
+		"Content-Type":  "application/json",
+	}, redactHeaders(headers))
+}
+
+func TestRedactURL(t *testing.T) {
+	t.Parallel()
+
+	parsed, _ := url.Parse("http://localhost:8989/api/v3/series?apikey=secret&term=test")
+	assert.Equal(t, "http://localhost:8989/api/v3/series?apikey=%2A%2A%2A&term=test", RedactURL(parsed))
+}
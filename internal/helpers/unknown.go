@@ -0,0 +1,20 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrUnknownConfiguration is returned by API calls made before the provider configuration is known.
+var ErrUnknownConfiguration = errors.New("provider configuration is not known yet")
+
+// UnknownConfigTransport fails every request, it is used until the provider configuration is known.
+type UnknownConfigTransport struct {
+	Attributes []string
+}
+
+func (t *UnknownConfigTransport) RoundTrip(_ *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("%w, unknown attributes: %s", ErrUnknownConfiguration, strings.Join(t.Attributes, ", "))
+}
@@ -0,0 +1,22 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnknownConfigTransport(t *testing.T) {
+	t.Parallel()
+
+	config := sonarr.NewConfiguration()
+	config.HTTPClient = &http.Client{Transport: &UnknownConfigTransport{Attributes: []string{"url", "api_key"}}}
+
+	_, _, err := sonarr.NewAPIClient(config).TagAPI.ListTag(context.Background()).Execute()
+	assert.True(t, errors.Is(err, ErrUnknownConfiguration))
+	assert.ErrorContains(t, err, "unknown attributes: url, api_key")
+}
@@ -0,0 +1,34 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ParseURL validates the Sonarr URL and splits it into the protocol and the host with its base path.
+func ParseURL(raw string) (string, string, error) {
+	if raw == "" {
+		return "", "", errors.New("URL cannot be empty")
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", "", fmt.Errorf("URL cannot be parsed: %w", err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", "", fmt.Errorf("URL '%s' must start with http:// or https://", raw)
+	}
+
+	if parsed.Host == "" {
+		return "", "", fmt.Errorf("URL '%s' must contain a host", raw)
+	}
+
+	if parsed.RawQuery != "" || parsed.Fragment != "" {
+		return "", "", fmt.Errorf("URL '%s' cannot contain a query or a fragment", raw)
+	}
+
+	return parsed.Scheme, parsed.Host + strings.TrimRight(parsed.EscapedPath(), "/"), nil
+}
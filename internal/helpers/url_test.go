@@ -0,0 +1,63 @@
+package helpers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		url      string
+		protocol string
+		hostpath string
+		err      string
+	}{
+		"host": {
+			url:      "http://localhost:8989",
+			protocol: "http",
+			hostpath: "localhost:8989",
+		},
+		"base_path": {
+			url:      "https://media.example.com/sonarr/",
+			protocol: "https",
+			hostpath: "media.example.com/sonarr",
+		},
+		"empty": {
+			url: "",
+			err: "URL cannot be empty",
+		},
+		"schemeless": {
+			url: "localhost:8989",
+			err: "URL 'localhost:8989' must start with http:// or https://",
+		},
+		"no_host": {
+			url: "http:///sonarr",
+			err: "URL 'http:///sonarr' must contain a host",
+		},
+		"query": {
+			url: "http://localhost:8989?test=true",
+			err: "URL 'http://localhost:8989?test=true' cannot contain a query or a fragment",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			protocol, hostpath, err := ParseURL(test.url)
+			if test.err != "" {
+				assert.EqualError(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.protocol, protocol)
+			assert.Equal(t, test.hostpath, hostpath)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const ClientWarning = "Client Warning"
+
+// validationFailure is a single entry of a Sonarr validation error response.
+type validationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	Severity     string `json:"severity"`
+	IsWarning    bool   `json:"isWarning"`
+}
+
+// schemaPaths is satisfied by the plan and state schemas.
+type schemaPaths interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+var (
+	camelBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+	propertyIndex = regexp.MustCompile(`\[\d+\]`)
+)
+
+// AddClientError reports a client error, mapping Sonarr validation failures to the related attributes.
+// Warning level failures are reported as warnings.
+func AddClientError(ctx context.Context, schema schemaPaths, action, name string, err error, diags *diag.Diagnostics) {
+	var (
+		apiErr   *sonarr.GenericOpenAPIError
+		changed  *ConcurrentChangeError
+		failures []validationFailure
+	)
+
+	if errors.As(err, &changed) {
+		diags.AddError(ConcurrentChange, fmt.Sprintf("Unable to %s %s, %s. Run a new plan to review them.", action, name, changed.Error()))
+
+		return
+	}
+
+	if !errors.As(err, &apiErr) || json.Unmarshal(apiErr.Body(), &failures) != nil || len(failures) == 0 {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+
+		return
+	}
+
+	hasError := false
+
+	for _, f := range failures {
+		detail := fmt.Sprintf("Unable to %s %s, got error: %s", action, name, f.ErrorMessage)
+		p, found := attributePath(ctx, schema, f.PropertyName)
+
+		if !found && f.PropertyName != "" {
+			detail = fmt.Sprintf("%s (%s)", detail, f.PropertyName)
+		}
+
+		warning := f.IsWarning || strings.EqualFold(f.Severity, "warning")
+		hasError = hasError || !warning
+
+		switch {
+		case warning && found:
+			diags.AddAttributeWarning(p, ClientWarning, detail)
+		case warning:
+			diags.AddWarning(ClientWarning, detail)
+		case found:
+			diags.AddAttributeError(p, ClientError, detail)
+		default:
+			diags.AddError(ClientError, detail)
+		}
+	}
+
+	// the request failed anyway
+	if !hasError {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+	}
+}
+
+// attributePath maps a Sonarr property name to an existing attribute of the schema.
+func attributePath(ctx context.Context, schema schemaPaths, property string) (path.Path, bool) {
+	if schema == nil || property == "" {
+		return path.Empty(), false
+	}
+
+	property = propertyIndex.ReplaceAllString(property, "")
+	segments := strings.Split(property, ".")
+	field := segments[len(segments)-1]
+	// try the field name first, then the name exceptions and finally the top level object
+	candidates := []string{
+		field,
+		selectTFNameFold(property),
+		selectTFNameFold(field),
+		segments[0],
+	}
+
+	for _, c := range candidates {
+		p := path.Root(toSnakeCase(c))
+		if _, diags := schema.TypeAtPath(ctx, p); !diags.HasError() {
+			return p, true
+		}
+	}
+
+	return path.Empty(), false
+}
+
+// selectTFNameFold identifies the TF name starting from a case insensitive API name.
+func selectTFNameFold(name string) string {
+	for _, f := range getFieldExceptions() {
+		if strings.EqualFold(f.apiName, name) {
+			return f.tfName
+		}
+	}
+
+	return name
+}
+
+func toSnakeCase(name string) string {
+	return strings.ToLower(camelBoundary.ReplaceAllString(name, "${1}_${2}"))
+}
+
+func toCamelCase(name string) string {
+	parts := strings.Split(name, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+
+	return strings.Join(parts, "")
+}
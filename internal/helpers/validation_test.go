@@ -0,0 +1,146 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":               schema.StringAttribute{Optional: true},
+			"quality_profile_id": schema.Int64Attribute{Optional: true},
+			"seed_time":          schema.Int64Attribute{Optional: true},
+			"tags":               schema.SetAttribute{Optional: true, ElementType: types.Int64Type},
+			"field_tags":         schema.SetAttribute{Optional: true, ElementType: types.StringType},
+			"specifications":     schema.SetAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
+
+	tests := map[string]struct {
+		property string
+		expected path.Path
+		found    bool
+	}{
+		"name": {
+			property: "Name",
+			expected: path.Root("name"),
+			found:    true,
+		},
+		"camel": {
+			property: "QualityProfileId",
+			expected: path.Root("quality_profile_id"),
+			found:    true,
+		},
+		"exception": {
+			property: "SeedCriteria.SeedTime",
+			expected: path.Root("seed_time"),
+			found:    true,
+		},
+		"tags": {
+			property: "Tags",
+			expected: path.Root("tags"),
+			found:    true,
+		},
+		"field_tags": {
+			property: "Settings.FieldTags",
+			expected: path.Root("field_tags"),
+			found:    true,
+		},
+		"nested": {
+			property: "Specifications[0].Value",
+			expected: path.Root("specifications"),
+			found:    true,
+		},
+		"missing": {
+			property: "Path",
+			expected: path.Empty(),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, found := attributePath(context.Background(), testSchema, test.property)
+			assert.Equal(t, test.found, found)
+			assert.Equal(t, test.expected, p)
+		})
+	}
+}
+
+func TestAddClientError(t *testing.T) {
+	t.Parallel()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Optional: true},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`[
+			{"propertyName":"Name","errorMessage":"Should be unique","severity":"error"},
+			{"propertyName":"Path","errorMessage":"Folder is not writable","severity":"error"},
+			{"propertyName":"Name","errorMessage":"Name is long","severity":"warning","isWarning":true}
+		]`))
+	}))
+	defer server.Close()
+
+	config := sonarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	_, _, apiErr := sonarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*sonarr.NewTagResource()).Execute()
+
+	tests := map[string]struct {
+		err      error
+		expected diag.Diagnostics
+	}{
+		"generic": {
+			err:      errors.New("other error"),
+			expected: diag.Diagnostics{diag.NewErrorDiagnostic(ClientError, "Unable to create tag, got error: other error")},
+		},
+		"validation": {
+			err: apiErr,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("name"), ClientError, "Unable to create tag, got error: Should be unique"),
+				diag.NewErrorDiagnostic(ClientError, "Unable to create tag, got error: Folder is not writable (Path)"),
+				diag.NewAttributeWarningDiagnostic(path.Root("name"), ClientWarning, "Unable to create tag, got error: Name is long"),
+			},
+		},
+		"concurrent_change": {
+			err: &url.Error{Op: "Put", URL: server.URL, Err: &ConcurrentChangeError{Attributes: []string{"label"}}},
+			expected: diag.Diagnostics{diag.NewErrorDiagnostic(
+				ConcurrentChange,
+				"Unable to create tag, the following attributes were changed outside of Terraform: label. Run a new plan to review them.",
+			)},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			AddClientError(context.Background(), testSchema, Create, "tag", test.err, &diags)
+			assert.Equal(t, test.expected, diags)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Minimum Sonarr versions for attributes introduced after v4.0.0.
+const (
+	MinUpgradeFormatScoreVersion = "4.0.0"
+	OnImportCompleteVersion      = "4.0.9"
+)
+
+const UnsupportedVersion = "Unsupported Sonarr Version"
+
+// CheckRequiredVersion verifies the connected Sonarr version against a constraint (e.g. `>= 4.0.0`).
+func CheckRequiredVersion(current *version.Version, constraint string) error {
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return fmt.Errorf("invalid version constraint '%s': %w", constraint, err)
+	}
+
+	if !constraints.Check(current) {
+		return fmt.Errorf("connected Sonarr version %s does not satisfy '%s'", current, constraint)
+	}
+
+	return nil
+}
+
+// CheckAttributeVersion reports an error if a configured attribute needs a newer Sonarr than the connected one.
+// Nothing is checked if the version is unknown or the attribute is not set in configuration.
+func CheckAttributeVersion(ctx context.Context, config tfsdk.Config, attribute path.Path, current *version.Version, minimum string, diags *diag.Diagnostics) {
+	var value attr.Value
+
+	if current == nil || config.Raw.IsNull() {
+		return
+	}
+
+	diags.Append(config.GetAttribute(ctx, attribute, &value)...)
+
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	// A disabled flag is harmless on older versions
+	if flag, ok := value.(types.Bool); ok && !flag.ValueBool() {
+		return
+	}
+
+	if current.LessThan(version.Must(version.NewVersion(minimum))) {
+		diags.AddAttributeError(attribute, UnsupportedVersion,
+			fmt.Sprintf("Attribute %s requires Sonarr %s or newer, connected version is %s.", attribute, minimum, current))
+	}
+}
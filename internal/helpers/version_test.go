@@ -0,0 +1,75 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckRequiredVersion(t *testing.T) {
+	t.Parallel()
+
+	current := version.Must(version.NewVersion("4.0.8.1874"))
+
+	assert.NoError(t, CheckRequiredVersion(current, ">= 4.0.0"))
+	assert.EqualError(t, CheckRequiredVersion(current, ">= 4.0.9"), "connected Sonarr version 4.0.8.1874 does not satisfy '>= 4.0.9'")
+	assert.ErrorContains(t, CheckRequiredVersion(current, "four"), "invalid version constraint 'four'")
+}
+
+func TestCheckAttributeVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value   tftypes.Value
+		version string
+		err     bool
+	}{
+		"supported": {
+			value:   tftypes.NewValue(tftypes.Bool, true),
+			version: "4.0.9.2244",
+		},
+		"unsupported": {
+			value:   tftypes.NewValue(tftypes.Bool, true),
+			version: "4.0.8.1874",
+			err:     true,
+		},
+		"disabled": {
+			value:   tftypes.NewValue(tftypes.Bool, false),
+			version: "4.0.8.1874",
+		},
+		"unset": {
+			value:   tftypes.NewValue(tftypes.Bool, nil),
+			version: "4.0.8.1874",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: schema.Schema{
+					Attributes: map[string]schema.Attribute{
+						"flag": schema.BoolAttribute{Optional: true},
+					},
+				},
+				Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"flag": tftypes.Bool}}, map[string]tftypes.Value{
+					"flag": test.value,
+				}),
+			}
+
+			var diags diag.Diagnostics
+
+			CheckAttributeVersion(context.Background(), config, path.Root("flag"), version.Must(version.NewVersion(test.version)), OnImportCompleteVersion, &diags)
+			assert.Equal(t, test.err, diags.HasError())
+		})
+	}
+}
@@ -95,6 +95,58 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"series_type": schema.StringAttribute{
+							MarkdownDescription: "Series type.",
+							Computed:            true,
+						},
+						"monitor_new_items": schema.StringAttribute{
+							MarkdownDescription: "Monitor new seasons.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Series status.",
+							Computed:            true,
+						},
+						"network": schema.StringAttribute{
+							MarkdownDescription: "Series network.",
+							Computed:            true,
+						},
+						"imdb_id": schema.StringAttribute{
+							MarkdownDescription: "IMDB ID.",
+							Computed:            true,
+						},
+						"tvmaze_id": schema.Int64Attribute{
+							MarkdownDescription: "TVMaze ID.",
+							Computed:            true,
+						},
+						"year": schema.Int64Attribute{
+							MarkdownDescription: "Series year.",
+							Computed:            true,
+						},
+						"genres": schema.SetAttribute{
+							MarkdownDescription: "List of genres.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"images": schema.SetNestedAttribute{
+							MarkdownDescription: "List of images.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: SeriesDataSource{}.getImageSchema().Attributes,
+							},
+						},
+						"statistics": schema.SingleNestedAttribute{
+							MarkdownDescription: "Series statistics.",
+							Computed:            true,
+							Attributes:          SeriesDataSource{}.getStatisticsSchema().Attributes,
+						},
+						"seasons": schema.SetNestedAttribute{
+							MarkdownDescription: "Seasons monitoring.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: SeriesDataSource{}.getSeasonSchema().Attributes,
+							},
+						},
 					},
 				},
 			},
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -152,7 +153,7 @@ func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest
 
 	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(r.auth).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, autoTagResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, autoTagResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -176,7 +177,7 @@ func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Get auto tag current value
 	response, _, err := r.client.AutoTaggingAPI.GetAutoTaggingById(r.auth, int32(autoTag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagResourceName, err))
+		helpers.HandleReadError(ctx, autoTagResourceName, err, resp)
 
 		return
 	}
@@ -200,9 +201,17 @@ func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(r.auth, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *AutoTag) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(auth, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, autoTagResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, autoTagResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -235,7 +244,19 @@ func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *AutoTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, autoTagResourceName, helpers.NameImportKey,
+		func() ([]sonarr.AutoTaggingResource, error) {
+			response, _, err := r.client.AutoTaggingAPI.ListAutoTagging(r.auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.AutoTaggingResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value)
+		},
+		func(item *sonarr.AutoTaggingResource) int32 {
+			return item.GetId()
+		},
+	)
 	tflog.Trace(ctx, "imported "+autoTagResourceName+": "+req.ID)
 }
 
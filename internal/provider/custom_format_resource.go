@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -33,6 +34,8 @@ func NewCustomFormatResource() resource.Resource {
 type CustomFormatResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
+	adopt  bool
 }
 
 // CustomFormat describes the custom format data model.
@@ -135,6 +138,8 @@ func (r *CustomFormatResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.adopt = resourceAdopt(req)
 	}
 }
 
@@ -151,9 +156,26 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
+	// Adopt the existing custom format with the same name
+	existing, err := r.findAdoptable(request.GetName())
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
+
+		return
+	}
+
+	var response *sonarr.CustomFormatResource
+
+	if existing != nil {
+		tflog.Info(ctx, "adopting existing "+customFormatResourceName+": "+strconv.Itoa(int(existing.GetId())))
+		request.SetId(existing.GetId())
+		response, _, err = r.client.CustomFormatAPI.UpdateCustomFormat(helpers.WithClearedAttributes(r.auth, clearedAttributes(req.Plan)), strconv.Itoa(int(existing.GetId()))).CustomFormatResource(*request).Execute()
+	} else {
+		response, _, err = r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
+	}
+
+	if err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, customFormatResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -178,9 +200,9 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, err := helpers.CachedGet(r.cache, customFormatResourceName, int32(client.ID.ValueInt64()), r.listCustomFormat, getCustomFormatID)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
+		helpers.HandleReadError(ctx, customFormatResourceName, err, resp)
 
 		return
 	}
@@ -207,9 +229,17 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(r.auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *CustomFormat) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, customFormatResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -245,7 +275,13 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, customFormatResourceName, helpers.NameImportKey,
+		r.listCustomFormat,
+		func(item *sonarr.CustomFormatResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value)
+		},
+		getCustomFormatID,
+	)
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
@@ -282,3 +318,24 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *sonar
 
 	return format
 }
+
+func (r *CustomFormatResource) listCustomFormat() ([]sonarr.CustomFormatResource, error) {
+	response, _, err := r.client.CustomFormatAPI.ListCustomFormat(r.auth).Execute()
+
+	return response, err
+}
+
+func getCustomFormatID(item *sonarr.CustomFormatResource) int32 {
+	return item.GetId()
+}
+
+// findAdoptable returns the custom format with the same name, if adoption is enabled.
+func (r *CustomFormatResource) findAdoptable(name string) (*sonarr.CustomFormatResource, error) {
+	if !r.adopt {
+		return nil, nil
+	}
+
+	return helpers.CachedFind(r.cache, customFormatResourceName, r.listCustomFormat, getCustomFormatID, func(format *sonarr.CustomFormatResource) bool {
+		return strings.EqualFold(format.GetName(), name)
+	})
+}
@@ -25,6 +25,7 @@ const delayProfileResourceName = "delay_profile"
 var (
 	_ resource.Resource                = &DelayProfileResource{}
 	_ resource.ResourceWithImportState = &DelayProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &DelayProfileResource{}
 )
 
 func NewDelayProfileResource() resource.Resource {
@@ -35,6 +36,7 @@ func NewDelayProfileResource() resource.Resource {
 type DelayProfileResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DelayProfile describes the delay profile data model.
@@ -145,9 +147,15 @@ func (r *DelayProfileResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DelayProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var profile *DelayProfile
@@ -164,7 +172,7 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new DelayProfile
 	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(r.auth).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, delayProfileResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, delayProfileResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -177,7 +185,7 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 
 		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+			helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, delayProfileResourceName, err, &resp.Diagnostics)
 
 			return
 		}
@@ -201,7 +209,7 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	// Get delayprofile current value
 	response, _, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
+		helpers.HandleReadError(ctx, delayProfileResourceName, err, resp)
 
 		return
 	}
@@ -225,10 +233,18 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DelayProfile) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DelayProfile
-	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, delayProfileResourceName, err, &resp.Diagnostics)
 
 		return
 	}
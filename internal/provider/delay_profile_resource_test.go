@@ -20,6 +20,11 @@ func TestAccDelayProfileResource(t *testing.T) {
 				Config:      testAccDelayProfileResourceConfig("usenet", "0") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Invalid reference
+			{
+				Config:      testAccDelayProfileResourceConfig("usenet", "9999"),
+				ExpectError: regexp.MustCompile("Invalid Reference"),
+			},
 			// Create and Read testing
 			{
 				Config: testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceConfig("usenet", "sonarr_tag.test.id"),
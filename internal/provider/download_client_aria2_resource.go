@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientAria2Resource{}
 	_ resource.ResourceWithImportState = &DownloadClientAria2Resource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientAria2Resource{}
 )
 
 func NewDownloadClientAria2Resource() resource.Resource {
@@ -37,6 +38,7 @@ func NewDownloadClientAria2Resource() resource.Resource {
 type DownloadClientAria2Resource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientAria2 describes the download client data model.
@@ -170,9 +172,15 @@ func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientAria2Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientAria2
@@ -188,7 +196,7 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientAria2ResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -212,7 +220,7 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	// Get DownloadClientAria2 current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientAria2ResourceName, err, resp)
 
 		return
 	}
@@ -236,9 +244,17 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientAria2) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientAria2ResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -271,8 +287,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientAria2ResourceName, downloadClientAria2Implementation, req, resp)
 }
 
 func (d *DownloadClientAria2) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -96,7 +96,7 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientConfig
 	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientConfigResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientConfigResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -144,10 +144,18 @@ func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.
 	// Build Update resource
 	request := config.read()
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientConfig) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientConfigResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientConfigResourceName, err, &resp.Diagnostics)
 
 		return
 	}
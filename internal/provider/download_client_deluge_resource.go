@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientDelugeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientDelugeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientDelugeResource{}
 )
 
 func NewDownloadClientDelugeResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientDelugeResource() resource.Resource {
 type DownloadClientDelugeResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientDeluge describes the download client data model.
@@ -217,9 +219,15 @@ func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientDelugeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientDeluge
@@ -235,7 +243,7 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientDelugeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -259,7 +267,7 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	// Get DownloadClientDeluge current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientDelugeResourceName, err, resp)
 
 		return
 	}
@@ -283,9 +291,17 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientDeluge) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientDelugeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -318,8 +334,7 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientDelugeResourceName, downloadClientDelugeImplementation, req, resp)
 }
 
 func (d *DownloadClientDeluge) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientFloodResource{}
 	_ resource.ResourceWithImportState = &DownloadClientFloodResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientFloodResource{}
 )
 
 func NewDownloadClientFloodResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewDownloadClientFloodResource() resource.Resource {
 type DownloadClientFloodResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientFlood describes the download client data model.
@@ -221,9 +223,15 @@ func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientFloodResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientFlood
@@ -239,7 +247,7 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientFloodResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -263,7 +271,7 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	// Get DownloadClientFlood current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFloodResourceName, err, resp)
 
 		return
 	}
@@ -287,9 +295,17 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientFlood) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientFloodResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -322,8 +338,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientFloodResourceName, downloadClientFloodImplementation, req, resp)
 }
 
 func (d *DownloadClientFlood) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientHadoukenResource{}
 	_ resource.ResourceWithImportState = &DownloadClientHadoukenResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientHadoukenResource{}
 )
 
 func NewDownloadClientHadoukenResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 type DownloadClientHadoukenResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientHadouken describes the download client data model.
@@ -184,9 +186,15 @@ func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientHadoukenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientHadouken
@@ -202,7 +210,7 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientHadoukenResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -226,7 +234,7 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	// Get DownloadClientHadouken current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientHadoukenResourceName, err, resp)
 
 		return
 	}
@@ -250,9 +258,17 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientHadouken) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientHadoukenResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -285,8 +301,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientHadoukenResourceName, downloadClientHadoukenImplementation, req, resp)
 }
 
 func (d *DownloadClientHadouken) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientNzbgetResource{}
 	_ resource.ResourceWithImportState = &DownloadClientNzbgetResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientNzbgetResource{}
 )
 
 func NewDownloadClientNzbgetResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 type DownloadClientNzbgetResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientNzbget describes the download client data model.
@@ -218,9 +220,15 @@ func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientNzbgetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientNzbget
@@ -236,7 +244,7 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientNzbgetResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -260,7 +268,7 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 	// Get DownloadClientNzbget current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientNzbgetResourceName, err, resp)
 
 		return
 	}
@@ -284,9 +292,17 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientNzbget) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientNzbgetResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -319,8 +335,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientNzbgetResourceName, downloadClientNzbgetImplementation, req, resp)
 }
 
 func (d *DownloadClientNzbget) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientNzbvortexResource{}
 	_ resource.ResourceWithImportState = &DownloadClientNzbvortexResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientNzbvortexResource{}
 )
 
 func NewDownloadClientNzbvortexResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientNzbvortexResource() resource.Resource {
 type DownloadClientNzbvortexResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientNzbvortex describes the download client data model.
@@ -193,9 +195,15 @@ func (r *DownloadClientNzbvortexResource) Configure(ctx context.Context, req res
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientNzbvortexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientNzbvortex
@@ -211,7 +219,7 @@ func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resour
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbvortexResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientNzbvortexResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -235,7 +243,7 @@ func (r *DownloadClientNzbvortexResource) Read(ctx context.Context, req resource
 	// Get DownloadClientNzbvortex current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbvortexResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientNzbvortexResourceName, err, resp)
 
 		return
 	}
@@ -259,9 +267,17 @@ func (r *DownloadClientNzbvortexResource) Update(ctx context.Context, req resour
 	// Update DownloadClientNzbvortex
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientNzbvortex) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbvortexResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientNzbvortexResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -294,8 +310,7 @@ func (r *DownloadClientNzbvortexResource) Delete(ctx context.Context, req resour
 }
 
 func (r *DownloadClientNzbvortexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientNzbvortexResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientNzbvortexResourceName, downloadClientNzbvortexImplementation, req, resp)
 }
 
 func (d *DownloadClientNzbvortex) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientPneumaticResource{}
 	_ resource.ResourceWithImportState = &DownloadClientPneumaticResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientPneumaticResource{}
 )
 
 func NewDownloadClientPneumaticResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewDownloadClientPneumaticResource() resource.Resource {
 type DownloadClientPneumaticResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientPneumatic describes the download client data model.
@@ -143,9 +145,15 @@ func (r *DownloadClientPneumaticResource) Configure(ctx context.Context, req res
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientPneumaticResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientPneumatic
@@ -161,7 +169,7 @@ func (r *DownloadClientPneumaticResource) Create(ctx context.Context, req resour
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientPneumaticResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientPneumaticResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -185,7 +193,7 @@ func (r *DownloadClientPneumaticResource) Read(ctx context.Context, req resource
 	// Get DownloadClientPneumatic current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientPneumaticResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientPneumaticResourceName, err, resp)
 
 		return
 	}
@@ -209,9 +217,17 @@ func (r *DownloadClientPneumaticResource) Update(ctx context.Context, req resour
 	// Update DownloadClientPneumatic
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientPneumatic) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientPneumaticResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientPneumaticResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -244,8 +260,7 @@ func (r *DownloadClientPneumaticResource) Delete(ctx context.Context, req resour
 }
 
 func (r *DownloadClientPneumaticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientPneumaticResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientPneumaticResourceName, downloadClientPneumaticImplementation, req, resp)
 }
 
 func (d *DownloadClientPneumatic) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -31,6 +31,7 @@ var downloadClientQbittorrentInitialStates = []int64{0, 1, 2}
 var (
 	_ resource.Resource                = &DownloadClientQbittorrentResource{}
 	_ resource.ResourceWithImportState = &DownloadClientQbittorrentResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientQbittorrentResource{}
 )
 
 func NewDownloadClientQbittorrentResource() resource.Resource {
@@ -41,6 +42,7 @@ func NewDownloadClientQbittorrentResource() resource.Resource {
 type DownloadClientQbittorrentResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientQbittorrent describes the download client data model.
@@ -247,9 +249,15 @@ func (r *DownloadClientQbittorrentResource) Configure(ctx context.Context, req r
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientQbittorrentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientQbittorrent
@@ -265,7 +273,7 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientQbittorrentResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientQbittorrentResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -289,7 +297,7 @@ func (r *DownloadClientQbittorrentResource) Read(ctx context.Context, req resour
 	// Get DownloadClientQbittorrent current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientQbittorrentResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientQbittorrentResourceName, err, resp)
 
 		return
 	}
@@ -313,9 +321,17 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 	// Update DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientQbittorrent) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientQbittorrentResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientQbittorrentResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -348,8 +364,7 @@ func (r *DownloadClientQbittorrentResource) Delete(ctx context.Context, req reso
 }
 
 func (r *DownloadClientQbittorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientQbittorrentResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientQbittorrentResourceName, downloadClientQbittorrentImplementation, req, resp)
 }
 
 func (d *DownloadClientQbittorrent) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
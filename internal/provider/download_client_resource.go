@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -26,6 +27,7 @@ const downloadClientResourceName = "download_client"
 var (
 	_ resource.Resource                = &DownloadClientResource{}
 	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientResource{}
 )
 
 var downloadClientFields = helpers.Fields{
@@ -45,6 +47,7 @@ func NewDownloadClientResource() resource.Resource {
 type DownloadClientResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClient describes the download client data model.
@@ -392,9 +395,15 @@ func (r *DownloadClientResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClient
@@ -410,7 +419,7 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -438,7 +447,7 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get DownloadClient current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientResourceName, err, resp)
 
 		return
 	}
@@ -466,9 +475,17 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClient) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -505,8 +522,7 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientResourceName, "", req, resp)
 }
 
 func (d *DownloadClient) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -561,3 +577,21 @@ func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 		d.SecretToken = client.SecretToken
 	}
 }
+
+// importDownloadClientState imports a download client by ID or by name, matching the implementation if set.
+func importDownloadClientState(ctx context.Context, client *sonarr.APIClient, auth context.Context, name, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, name, helpers.NameImportKey,
+		func() ([]sonarr.DownloadClientResource, error) {
+			response, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.DownloadClientResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value) && (implementation == "" || item.GetImplementation() == implementation)
+		},
+		func(item *sonarr.DownloadClientResource) int32 {
+			return item.GetId()
+		},
+	)
+	tflog.Trace(ctx, "imported "+name+": "+req.ID)
+}
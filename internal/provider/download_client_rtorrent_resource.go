@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientRtorrentResource{}
 	_ resource.ResourceWithImportState = &DownloadClientRtorrentResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientRtorrentResource{}
 )
 
 func NewDownloadClientRtorrentResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientRtorrentResource() resource.Resource {
 type DownloadClientRtorrentResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientRtorrent describes the download client data model.
@@ -234,9 +236,15 @@ func (r *DownloadClientRtorrentResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientRtorrentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientRtorrentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientRtorrent
@@ -252,7 +260,7 @@ func (r *DownloadClientRtorrentResource) Create(ctx context.Context, req resourc
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientRtorrentResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientRtorrentResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -276,7 +284,7 @@ func (r *DownloadClientRtorrentResource) Read(ctx context.Context, req resource.
 	// Get DownloadClientRtorrent current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientRtorrentResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientRtorrentResourceName, err, resp)
 
 		return
 	}
@@ -300,9 +308,17 @@ func (r *DownloadClientRtorrentResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientRtorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientRtorrent) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientRtorrentResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientRtorrentResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -335,8 +351,7 @@ func (r *DownloadClientRtorrentResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientRtorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientRtorrentResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientRtorrentResourceName, downloadClientRtorrentImplementation, req, resp)
 }
 
 func (d *DownloadClientRtorrent) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
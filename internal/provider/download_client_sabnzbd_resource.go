@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientSabnzbdResource{}
 	_ resource.ResourceWithImportState = &DownloadClientSabnzbdResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientSabnzbdResource{}
 )
 
 func NewDownloadClientSabnzbdResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientSabnzbdResource() resource.Resource {
 type DownloadClientSabnzbdResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientSabnzbd describes the download client data model.
@@ -219,9 +221,15 @@ func (r *DownloadClientSabnzbdResource) Configure(ctx context.Context, req resou
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientSabnzbdResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientSabnzbd
@@ -237,7 +245,7 @@ func (r *DownloadClientSabnzbdResource) Create(ctx context.Context, req resource
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientSabnzbdResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientSabnzbdResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -261,7 +269,7 @@ func (r *DownloadClientSabnzbdResource) Read(ctx context.Context, req resource.R
 	// Get DownloadClientSabnzbd current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientSabnzbdResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientSabnzbdResourceName, err, resp)
 
 		return
 	}
@@ -285,9 +293,17 @@ func (r *DownloadClientSabnzbdResource) Update(ctx context.Context, req resource
 	// Update DownloadClientSabnzbd
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientSabnzbd) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientSabnzbdResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientSabnzbdResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -320,8 +336,7 @@ func (r *DownloadClientSabnzbdResource) Delete(ctx context.Context, req resource
 }
 
 func (r *DownloadClientSabnzbdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientSabnzbdResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientSabnzbdResourceName, downloadClientSabnzbdImplementation, req, resp)
 }
 
 func (d *DownloadClientSabnzbd) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientTorrentBlackholeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientTorrentBlackholeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientTorrentBlackholeResource{}
 )
 
 func NewDownloadClientTorrentBlackholeResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewDownloadClientTorrentBlackholeResource() resource.Resource {
 type DownloadClientTorrentBlackholeResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientTorrentBlackhole describes the download client data model.
@@ -167,9 +169,15 @@ func (r *DownloadClientTorrentBlackholeResource) Configure(ctx context.Context,
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientTorrentBlackholeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientTorrentBlackhole
@@ -185,7 +193,7 @@ func (r *DownloadClientTorrentBlackholeResource) Create(ctx context.Context, req
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTorrentBlackholeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientTorrentBlackholeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -209,7 +217,7 @@ func (r *DownloadClientTorrentBlackholeResource) Read(ctx context.Context, req r
 	// Get DownloadClientTorrentBlackhole current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientTorrentBlackholeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientTorrentBlackholeResourceName, err, resp)
 
 		return
 	}
@@ -233,9 +241,17 @@ func (r *DownloadClientTorrentBlackholeResource) Update(ctx context.Context, req
 	// Update DownloadClientTorrentBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientTorrentBlackhole) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientTorrentBlackholeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientTorrentBlackholeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -268,8 +284,7 @@ func (r *DownloadClientTorrentBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientTorrentBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientTorrentBlackholeResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientTorrentBlackholeResourceName, downloadClientTorrentBlackholeImplementation, req, resp)
 }
 
 func (d *DownloadClientTorrentBlackhole) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientTransmissionResource{}
 	_ resource.ResourceWithImportState = &DownloadClientTransmissionResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientTransmissionResource{}
 )
 
 func NewDownloadClientTransmissionResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientTransmissionResource() resource.Resource {
 type DownloadClientTransmissionResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientTransmission describes the download client data model.
@@ -226,9 +228,15 @@ func (r *DownloadClientTransmissionResource) Configure(ctx context.Context, req
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientTransmissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientTransmissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientTransmission
@@ -244,7 +252,7 @@ func (r *DownloadClientTransmissionResource) Create(ctx context.Context, req res
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientTransmissionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientTransmissionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -268,7 +276,7 @@ func (r *DownloadClientTransmissionResource) Read(ctx context.Context, req resou
 	// Get DownloadClientTransmission current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientTransmissionResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientTransmissionResourceName, err, resp)
 
 		return
 	}
@@ -292,9 +300,17 @@ func (r *DownloadClientTransmissionResource) Update(ctx context.Context, req res
 	// Update DownloadClientTransmission
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientTransmission) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientTransmissionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientTransmissionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -327,8 +343,7 @@ func (r *DownloadClientTransmissionResource) Delete(ctx context.Context, req res
 }
 
 func (r *DownloadClientTransmissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientTransmissionResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientTransmissionResourceName, downloadClientTransmissionImplementation, req, resp)
 }
 
 func (d *DownloadClientTransmission) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
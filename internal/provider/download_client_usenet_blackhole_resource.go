@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientUsenetBlackholeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientUsenetBlackholeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientUsenetBlackholeResource{}
 )
 
 func NewDownloadClientUsenetBlackholeResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewDownloadClientUsenetBlackholeResource() resource.Resource {
 type DownloadClientUsenetBlackholeResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientUsenetBlackhole describes the download client data model.
@@ -143,9 +145,15 @@ func (r *DownloadClientUsenetBlackholeResource) Configure(ctx context.Context, r
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientUsenetBlackholeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientUsenetBlackhole
@@ -161,7 +169,7 @@ func (r *DownloadClientUsenetBlackholeResource) Create(ctx context.Context, req
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUsenetBlackholeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientUsenetBlackholeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -185,7 +193,7 @@ func (r *DownloadClientUsenetBlackholeResource) Read(ctx context.Context, req re
 	// Get DownloadClientUsenetBlackhole current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientUsenetBlackholeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientUsenetBlackholeResourceName, err, resp)
 
 		return
 	}
@@ -209,9 +217,17 @@ func (r *DownloadClientUsenetBlackholeResource) Update(ctx context.Context, req
 	// Update DownloadClientUsenetBlackhole
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientUsenetBlackhole) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientUsenetBlackholeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientUsenetBlackholeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -244,8 +260,7 @@ func (r *DownloadClientUsenetBlackholeResource) Delete(ctx context.Context, req
 }
 
 func (r *DownloadClientUsenetBlackholeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientUsenetBlackholeResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientUsenetBlackholeResourceName, downloadClientUsenetBlackholeImplementation, req, resp)
 }
 
 func (d *DownloadClientUsenetBlackhole) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
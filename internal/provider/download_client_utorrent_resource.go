@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientUtorrentResource{}
 	_ resource.ResourceWithImportState = &DownloadClientUtorrentResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientUtorrentResource{}
 )
 
 func NewDownloadClientUtorrentResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientUtorrentResource() resource.Resource {
 type DownloadClientUtorrentResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientUtorrent describes the download client data model.
@@ -229,9 +231,15 @@ func (r *DownloadClientUtorrentResource) Configure(ctx context.Context, req reso
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientUtorrentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientUtorrentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientUtorrent
@@ -247,7 +255,7 @@ func (r *DownloadClientUtorrentResource) Create(ctx context.Context, req resourc
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUtorrentResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientUtorrentResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -271,7 +279,7 @@ func (r *DownloadClientUtorrentResource) Read(ctx context.Context, req resource.
 	// Get DownloadClientUtorrent current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientUtorrentResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientUtorrentResourceName, err, resp)
 
 		return
 	}
@@ -295,9 +303,17 @@ func (r *DownloadClientUtorrentResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientUtorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientUtorrent) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientUtorrentResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientUtorrentResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -330,8 +346,7 @@ func (r *DownloadClientUtorrentResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientUtorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientUtorrentResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientUtorrentResourceName, downloadClientUtorrentImplementation, req, resp)
 }
 
 func (d *DownloadClientUtorrent) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -29,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &DownloadClientVuzeResource{}
 	_ resource.ResourceWithImportState = &DownloadClientVuzeResource{}
+	_ resource.ResourceWithModifyPlan  = &DownloadClientVuzeResource{}
 )
 
 func NewDownloadClientVuzeResource() resource.Resource {
@@ -39,6 +40,7 @@ func NewDownloadClientVuzeResource() resource.Resource {
 type DownloadClientVuzeResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // DownloadClientVuze describes the download client data model.
@@ -226,9 +228,15 @@ func (r *DownloadClientVuzeResource) Configure(ctx context.Context, req resource
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *DownloadClientVuzeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *DownloadClientVuzeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var client *DownloadClientVuze
@@ -244,7 +252,7 @@ func (r *DownloadClientVuzeResource) Create(ctx context.Context, req resource.Cr
 
 	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientVuzeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, downloadClientVuzeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -268,7 +276,7 @@ func (r *DownloadClientVuzeResource) Read(ctx context.Context, req resource.Read
 	// Get DownloadClientVuze current value
 	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientVuzeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientVuzeResourceName, err, resp)
 
 		return
 	}
@@ -292,9 +300,17 @@ func (r *DownloadClientVuzeResource) Update(ctx context.Context, req resource.Up
 	// Update DownloadClientVuze
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *DownloadClientVuze) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientVuzeResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, downloadClientVuzeResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -327,8 +343,7 @@ func (r *DownloadClientVuzeResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *DownloadClientVuzeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+downloadClientVuzeResourceName+": "+req.ID)
+	importDownloadClientState(ctx, r.client, r.auth, downloadClientVuzeResourceName, downloadClientVuzeImplementation, req, resp)
 }
 
 func (d *DownloadClientVuze) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodeDataSourceName = "episode"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EpisodeDataSource{}
+
+func NewEpisodeDataSource() datasource.DataSource {
+	return &EpisodeDataSource{}
+}
+
+// EpisodeDataSource defines the episode implementation.
+type EpisodeDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *EpisodeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodeDataSourceName
+}
+
+func (d *EpisodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nSingle [Episode](../resources/episode).",
+		Attributes: map[string]schema.Attribute{
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+			},
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Season number.",
+				Required:            true,
+			},
+			"episode_number": schema.Int64Attribute{
+				MarkdownDescription: "Episode number.",
+				Required:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Episode ID.",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Episode title.",
+				Computed:            true,
+			},
+			"air_date": schema.StringAttribute{
+				MarkdownDescription: "Air date.",
+				Computed:            true,
+			},
+			"air_date_utc": schema.StringAttribute{
+				MarkdownDescription: "Air date UTC in RFC3339 format.",
+				Computed:            true,
+			},
+			"absolute_episode_number": schema.Int64Attribute{
+				MarkdownDescription: "Absolute episode number.",
+				Computed:            true,
+			},
+			"episode_file_id": schema.Int64Attribute{
+				MarkdownDescription: "Episode file ID.",
+				Computed:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EpisodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *EpisodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Episode
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get episode current value
+	response, _, err := d.client.EpisodeAPI.ListEpisode(d.auth).SeriesId(int32(data.SeriesID.ValueInt64())).SeasonNumber(int32(data.SeasonNumber.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, episodeDataSourceName, err))
+
+		return
+	}
+
+	data.find(data.EpisodeNumber.ValueInt64(), response, &resp.Diagnostics)
+	tflog.Trace(ctx, "read "+episodeDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (e *Episode) find(number int64, episodes []sonarr.EpisodeResource, diags *diag.Diagnostics) {
+	for _, episode := range episodes {
+		if int64(episode.GetEpisodeNumber()) == number {
+			e.write(&episode)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(episodeDataSourceName, "episode number", strconv.Itoa(int(number))))
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodeDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccEpisodeDataSourceConfig("1", 1) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Not found testing
+			{
+				Config:      testAccEpisodeDataSourceConfig("1", 999),
+				ExpectError: regexp.MustCompile("Unable to find episode"),
+			},
+			// Read testing
+			{
+				Config: testAccEpisodeDataSourceSeriesConfig + testAccEpisodeDataSourceConfig("sonarr_series.episodes.id", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_episode.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.test", "title", "Pilot"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.test", "has_file", "false")),
+			},
+		},
+	})
+}
+
+const testAccEpisodeDataSourceSeriesConfig = `
+resource "sonarr_series" "episodes" {
+	title      = "Lost"
+	title_slug = "lost"
+	tvdb_id    = 73739
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+	path                = "/config/lost"
+	root_folder_path    = "/config"
+
+	quality_profile_id  = 1
+}
+`
+
+func testAccEpisodeDataSourceConfig(series string, episode int) string {
+	return fmt.Sprintf(`
+	data "sonarr_episode" "test" {
+		series_id      = %s
+		season_number  = 1
+		episode_number = %d
+	}
+	`, series, episode)
+}
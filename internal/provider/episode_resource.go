@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodeResourceName = "episode"
+
+// The episodes of a series just added are fetched by Sonarr in background, they are polled with backoff for a while.
+const (
+	episodeLookupTimeout = 2 * time.Minute
+	episodeLookupWaitMin = 250 * time.Millisecond
+	episodeLookupWaitMax = 5 * time.Second
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &EpisodeResource{}
+	_ resource.ResourceWithImportState = &EpisodeResource{}
+)
+
+func NewEpisodeResource() resource.Resource {
+	return &EpisodeResource{}
+}
+
+// EpisodeResource defines the episode implementation.
+type EpisodeResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Episode describes the episode data model.
+type Episode struct {
+	Title                 types.String `tfsdk:"title"`
+	AirDate               types.String `tfsdk:"air_date"`
+	AirDateUtc            types.String `tfsdk:"air_date_utc"`
+	ID                    types.Int64  `tfsdk:"id"`
+	SeriesID              types.Int64  `tfsdk:"series_id"`
+	SeasonNumber          types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber         types.Int64  `tfsdk:"episode_number"`
+	AbsoluteEpisodeNumber types.Int64  `tfsdk:"absolute_episode_number"`
+	EpisodeFileID         types.Int64  `tfsdk:"episode_file_id"`
+	Monitored             types.Bool   `tfsdk:"monitored"`
+	HasFile               types.Bool   `tfsdk:"has_file"`
+}
+
+func (e Episode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":                   types.StringType,
+			"air_date":                types.StringType,
+			"air_date_utc":            types.StringType,
+			"id":                      types.Int64Type,
+			"series_id":               types.Int64Type,
+			"season_number":           types.Int64Type,
+			"episode_number":          types.Int64Type,
+			"absolute_episode_number": types.Int64Type,
+			"episode_file_id":         types.Int64Type,
+			"monitored":               types.BoolType,
+			"has_file":                types.BoolType,
+		})
+}
+
+func (r *EpisodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodeResourceName
+}
+
+func (r *EpisodeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nEpisode resource.\nEpisodes cannot be created or deleted, this resource manages the monitoring of an existing episode.\nThe episodes of a series just added are waited for up to two minutes, while Sonarr fetches them.\nFor more information refer to [Series](https://wiki.servarr.com/sonarr/library#series) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Season number.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"episode_number": schema.Int64Attribute{
+				MarkdownDescription: "Episode number.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Required:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Episode ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Episode title.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"air_date": schema.StringAttribute{
+				MarkdownDescription: "Air date.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"air_date_utc": schema.StringAttribute{
+				MarkdownDescription: "Air date UTC in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"absolute_episode_number": schema.Int64Attribute{
+				MarkdownDescription: "Absolute episode number.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"episode_file_id": schema.Int64Attribute{
+				MarkdownDescription: "Episode file ID.",
+				Computed:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *EpisodeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *EpisodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var episode *Episode
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &episode)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Episodes cannot be created, find the existing one to get its ID
+	id, err := r.find(ctx, episode)
+
+	switch {
+	case errors.Is(err, helpers.ErrNotFound):
+		resp.Diagnostics.AddError(helpers.ResourceError, helpers.ParseNotFoundError(episodeResourceName, "episode number", strconv.Itoa(int(episode.EpisodeNumber.ValueInt64()))))
+
+		return
+	case err != nil:
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, episodeResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
+	episode.ID = types.Int64Value(int64(id))
+
+	response, err := r.monitor(episode)
+	if err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, episodeResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+episodeResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	episode.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &episode)...)
+}
+
+func (r *EpisodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var episode *Episode
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &episode)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get episode current value
+	response, _, err := r.client.EpisodeAPI.GetEpisodeById(r.auth, int32(episode.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, episodeResourceName, err, resp)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+episodeResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	episode.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &episode)...)
+}
+
+func (r *EpisodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var episode *Episode
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &episode)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update Episode
+	response, err := r.monitor(episode)
+	if err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, episodeResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+episodeResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	episode.write(response)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &episode)...)
+}
+
+func (r *EpisodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Episodes cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+episodeResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *EpisodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+episodeResourceName+": "+req.ID)
+}
+
+// monitor sets the episode monitored flag and returns the updated episode.
+// find lists the season episodes until the episode shows up, the timeout expires or ctx is canceled.
+func (r *EpisodeResource) find(ctx context.Context, episode *Episode) (int32, error) {
+	deadline, cancel := context.WithTimeout(r.auth, episodeLookupTimeout)
+	defer cancel()
+
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	for wait := episodeLookupWaitMin; ; wait = min(2*wait, episodeLookupWaitMax) {
+		episodes, _, err := r.client.EpisodeAPI.ListEpisode(deadline).SeriesId(int32(episode.SeriesID.ValueInt64())).SeasonNumber(int32(episode.SeasonNumber.ValueInt64())).Execute()
+		if err != nil {
+			return 0, err
+		}
+
+		for _, e := range episodes {
+			if int64(e.GetEpisodeNumber()) == episode.EpisodeNumber.ValueInt64() {
+				return e.GetId(), nil
+			}
+		}
+
+		tflog.Debug(ctx, "waiting for "+episodeResourceName+" to be listed: "+strconv.Itoa(int(episode.EpisodeNumber.ValueInt64())))
+
+		select {
+		case <-deadline.Done():
+			return 0, helpers.ErrNotFound
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (r *EpisodeResource) monitor(episode *Episode) (*sonarr.EpisodeResource, error) {
+	request := sonarr.NewEpisodesMonitoredResource()
+	request.SetEpisodeIds([]int32{int32(episode.ID.ValueInt64())})
+	request.SetMonitored(episode.Monitored.ValueBool())
+
+	if _, err := r.client.EpisodeAPI.PutEpisodeMonitor(r.auth).EpisodesMonitoredResource(*request).Execute(); err != nil {
+		return nil, err
+	}
+
+	response, _, err := r.client.EpisodeAPI.GetEpisodeById(r.auth, int32(episode.ID.ValueInt64())).Execute()
+
+	return response, err
+}
+
+func (e *Episode) write(episode *sonarr.EpisodeResource) {
+	e.ID = types.Int64Value(int64(episode.GetId()))
+	e.SeriesID = types.Int64Value(int64(episode.GetSeriesId()))
+	e.SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+	e.EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+	e.EpisodeFileID = types.Int64Value(int64(episode.GetEpisodeFileId()))
+	e.Title = types.StringValue(episode.GetTitle())
+	e.AirDate = types.StringValue(episode.GetAirDate())
+	e.Monitored = types.BoolValue(episode.GetMonitored())
+	e.HasFile = types.BoolValue(episode.GetHasFile())
+	e.AirDateUtc = types.StringNull()
+	e.AbsoluteEpisodeNumber = types.Int64Null()
+
+	if airDate, ok := episode.GetAirDateUtcOk(); ok && airDate != nil {
+		e.AirDateUtc = types.StringValue(airDate.Format(time.RFC3339))
+	}
+
+	if number, ok := episode.GetAbsoluteEpisodeNumberOk(); ok && number != nil {
+		e.AbsoluteEpisodeNumber = types.Int64Value(int64(*number))
+	}
+}
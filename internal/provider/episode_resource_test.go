@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodeResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccEpisodeResourceConfig("false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccEpisodeResourceConfig("false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("sonarr_episode.test", "title", "Serenity"),
+					resource.TestCheckResourceAttrSet("sonarr_episode.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccEpisodeResourceConfig("false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccEpisodeResourceConfig("true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode.test", "monitored", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_episode.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEpisodeResourceConfig(monitored string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "episode" {
+		title      = "Firefly"
+		title_slug = "firefly"
+		tvdb_id    = 78874
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/firefly"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+	}
+
+	resource "sonarr_episode" "test" {
+		series_id      = sonarr_series.episode.id
+		season_number  = 1
+		episode_number = 1
+		monitored      = %s
+	}
+	`, monitored)
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodesDataSourceName = "episodes"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EpisodesDataSource{}
+
+func NewEpisodesDataSource() datasource.DataSource {
+	return &EpisodesDataSource{}
+}
+
+// EpisodesDataSource defines the episodes implementation.
+type EpisodesDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Episodes describes the episodes data model.
+type Episodes struct {
+	Episodes types.Set    `tfsdk:"episodes"`
+	ID       types.String `tfsdk:"id"`
+	SeriesID types.Int64  `tfsdk:"series_id"`
+}
+
+func (d *EpisodesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodesDataSourceName
+}
+
+func (d *EpisodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nList all [Episodes](../resources/episode) of a series.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+			},
+			"episodes": schema.SetNestedAttribute{
+				MarkdownDescription: "Episode list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Episode number.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Episode title.",
+							Computed:            true,
+						},
+						"air_date": schema.StringAttribute{
+							MarkdownDescription: "Air date.",
+							Computed:            true,
+						},
+						"air_date_utc": schema.StringAttribute{
+							MarkdownDescription: "Air date UTC in RFC3339 format.",
+							Computed:            true,
+						},
+						"absolute_episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Absolute episode number.",
+							Computed:            true,
+						},
+						"episode_file_id": schema.Int64Attribute{
+							MarkdownDescription: "Episode file ID.",
+							Computed:            true,
+						},
+						"has_file": schema.BoolAttribute{
+							MarkdownDescription: "Has file flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EpisodesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *EpisodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Episodes
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get episodes current value
+	response, _, err := d.client.EpisodeAPI.ListEpisode(d.auth).SeriesId(int32(data.SeriesID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, episodesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+episodesDataSourceName)
+	// Map response body to resource schema attribute
+	episodes := make([]Episode, len(response))
+	for i, e := range response {
+		episodes[i].write(&e)
+	}
+
+	episodeList, diags := types.SetValueFrom(ctx, Episode{}.getType(), episodes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Episodes = episodeList
+	data.ID = types.StringValue(strconv.Itoa(len(response)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
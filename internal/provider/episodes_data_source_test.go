@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccEpisodesDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccEpisodesDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_episodes.test", "episodes.*", map[string]string{"season_number": "1", "episode_number": "1"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccEpisodesDataSourceConfig = `
+resource "sonarr_series" "episodes_list" {
+	title      = "Friends"
+	title_slug = "friends"
+	tvdb_id    = 79168
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+	path                = "/config/friends"
+	root_folder_path    = "/config"
+
+	quality_profile_id  = 1
+}
+
+data "sonarr_episodes" "test" {
+	series_id = sonarr_series.episodes_list.id
+}
+`
@@ -426,7 +426,7 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	// Create new Host
 	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, hostResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -474,10 +474,18 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	// Build Update resource
 	request := host.read(ctx, &resp.Diagnostics)
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *Host) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, hostResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -170,7 +170,7 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCustomResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListCustomResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -194,7 +194,7 @@ func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRe
 	// Get ImportListCustom current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListCustomResourceName, err))
+		helpers.HandleReadError(ctx, importListCustomResourceName, err, resp)
 
 		return
 	}
@@ -218,9 +218,17 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListCustom) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCustomResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListCustomResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -253,8 +261,7 @@ func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListCustomResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListCustomResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListCustomResourceName, importListCustomImplementation, req, resp)
 }
 
 func (i *ImportListCustom) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
@@ -99,7 +99,7 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.ImportListExclusionAPI.CreateImportListExclusion(r.auth).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListExclusionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListExclusionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -123,7 +123,7 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 	// Get importListExclusion current value
 	response, _, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
+		helpers.HandleReadError(ctx, importListExclusionResourceName, err, resp)
 
 		return
 	}
@@ -147,9 +147,17 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 	// Update ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(r.auth, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListExclusion) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(auth, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListExclusionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListExclusionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -182,7 +190,19 @@ func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListExclusionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, importListExclusionResourceName, helpers.TvdbImportKey,
+		func() ([]sonarr.ImportListExclusionResource, error) {
+			response, _, err := r.client.ImportListExclusionAPI.ListImportListExclusion(r.auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.ImportListExclusionResource, value string) bool {
+			return strconv.Itoa(int(item.GetTvdbId())) == value
+		},
+		func(item *sonarr.ImportListExclusionResource) int32 {
+			return item.GetId()
+		},
+	)
 	tflog.Trace(ctx, "imported "+importListExclusionResourceName+": "+req.ID)
 }
 
@@ -170,7 +170,7 @@ func (r *ImportListImdbResource) Create(ctx context.Context, req resource.Create
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListImdbResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListImdbResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -194,7 +194,7 @@ func (r *ImportListImdbResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get ImportListImdb current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListImdbResourceName, err))
+		helpers.HandleReadError(ctx, importListImdbResourceName, err, resp)
 
 		return
 	}
@@ -218,9 +218,17 @@ func (r *ImportListImdbResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListImdb) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListImdbResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListImdbResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -253,8 +261,7 @@ func (r *ImportListImdbResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListImdbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListImdbResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListImdbResourceName, importListImdbImplementation, req, resp)
 }
 
 func (i *ImportListImdb) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
@@ -171,7 +171,7 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListPlexResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -195,7 +195,7 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get ImportListPlex current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexResourceName, err))
+		helpers.HandleReadError(ctx, importListPlexResourceName, err, resp)
 
 		return
 	}
@@ -219,9 +219,17 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListPlex) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListPlexResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -254,8 +262,7 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListPlexResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListPlexResourceName, importListPlexImplementation, req, resp)
 }
 
 func (i *ImportListPlex) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
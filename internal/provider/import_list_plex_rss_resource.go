@@ -170,7 +170,7 @@ func (r *ImportListPlexRSSResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexRSSResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListPlexRSSResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -194,7 +194,7 @@ func (r *ImportListPlexRSSResource) Read(ctx context.Context, req resource.ReadR
 	// Get ImportListPlexRSS current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexRSSResourceName, err))
+		helpers.HandleReadError(ctx, importListPlexRSSResourceName, err, resp)
 
 		return
 	}
@@ -218,9 +218,17 @@ func (r *ImportListPlexRSSResource) Update(ctx context.Context, req resource.Upd
 	// Update ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListPlexRSS) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexRSSResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListPlexRSSResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -253,8 +261,7 @@ func (r *ImportListPlexRSSResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ImportListPlexRSSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListPlexRSSResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListPlexRSSResourceName, importListPlexRSSImplementation, req, resp)
 }
 
 func (i *ImportListPlexRSS) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -309,7 +310,7 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -336,7 +337,7 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get ImportList current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
+		helpers.HandleReadError(ctx, importListResourceName, err, resp)
 
 		return
 	}
@@ -363,9 +364,17 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportList) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -401,8 +410,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListResourceName, "", req, resp)
 }
 
 func (i *ImportList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
@@ -444,3 +452,21 @@ func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.
 
 	return list
 }
+
+// importImportListState imports a import list by ID or by name, matching the implementation if set.
+func importImportListState(ctx context.Context, client *sonarr.APIClient, auth context.Context, name, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, name, helpers.NameImportKey,
+		func() ([]sonarr.ImportListResource, error) {
+			response, _, err := client.ImportListAPI.ListImportList(auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.ImportListResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value) && (implementation == "" || item.GetImplementation() == implementation)
+		},
+		func(item *sonarr.ImportListResource) int32 {
+			return item.GetId()
+		},
+	)
+	tflog.Trace(ctx, "imported "+name+": "+req.ID)
+}
@@ -208,7 +208,7 @@ func (r *ImportListSimklUserResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSimklUserResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListSimklUserResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -232,7 +232,7 @@ func (r *ImportListSimklUserResource) Read(ctx context.Context, req resource.Rea
 	// Get ImportListSimklUser current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSimklUserResourceName, err))
+		helpers.HandleReadError(ctx, importListSimklUserResourceName, err, resp)
 
 		return
 	}
@@ -256,9 +256,17 @@ func (r *ImportListSimklUserResource) Update(ctx context.Context, req resource.U
 	// Update ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListSimklUser) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSimklUserResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListSimklUserResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -291,8 +299,7 @@ func (r *ImportListSimklUserResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListSimklUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListSimklUserResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListSimklUserResourceName, importListSimklUserImplementation, req, resp)
 }
 
 func (i *ImportListSimklUser) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
@@ -205,7 +205,7 @@ func (r *ImportListSonarrResource) Create(ctx context.Context, req resource.Crea
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSonarrResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListSonarrResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -229,7 +229,7 @@ func (r *ImportListSonarrResource) Read(ctx context.Context, req resource.ReadRe
 	// Get ImportListSonarr current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSonarrResourceName, err))
+		helpers.HandleReadError(ctx, importListSonarrResourceName, err, resp)
 
 		return
 	}
@@ -253,9 +253,17 @@ func (r *ImportListSonarrResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListSonarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListSonarr) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSonarrResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListSonarrResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -288,8 +296,7 @@ func (r *ImportListSonarrResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListSonarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListSonarrResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListSonarrResourceName, importListSonarrImplementation, req, resp)
 }
 
 func (i *ImportListSonarr) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
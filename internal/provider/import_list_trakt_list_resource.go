@@ -227,7 +227,7 @@ func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktListResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListTraktListResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -251,7 +251,7 @@ func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.Rea
 	// Get ImportListTraktList current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktListResourceName, err))
+		helpers.HandleReadError(ctx, importListTraktListResourceName, err, resp)
 
 		return
 	}
@@ -275,9 +275,17 @@ func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.U
 	// Update ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListTraktList) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktListResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListTraktListResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -310,8 +318,7 @@ func (r *ImportListTraktListResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListTraktListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListTraktListResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListTraktListResourceName, importListTraktListImplementation, req, resp)
 }
 
 func (i *ImportListTraktList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
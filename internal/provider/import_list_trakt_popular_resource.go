@@ -248,7 +248,7 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktPopularResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListTraktPopularResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -272,7 +272,7 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 	// Get ImportListTraktPopular current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktPopularResourceName, err))
+		helpers.HandleReadError(ctx, importListTraktPopularResourceName, err, resp)
 
 		return
 	}
@@ -296,9 +296,17 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 	// Update ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListTraktPopular) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktPopularResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListTraktPopularResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -331,8 +339,7 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *ImportListTraktPopularResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListTraktPopularResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListTraktPopularResourceName, importListTraktPopularImplementation, req, resp)
 }
 
 func (i *ImportListTraktPopular) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
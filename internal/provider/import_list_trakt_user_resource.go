@@ -231,7 +231,7 @@ func (r *ImportListTraktUserResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktUserResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, importListTraktUserResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -255,7 +255,7 @@ func (r *ImportListTraktUserResource) Read(ctx context.Context, req resource.Rea
 	// Get ImportListTraktUser current value
 	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktUserResourceName, err))
+		helpers.HandleReadError(ctx, importListTraktUserResourceName, err, resp)
 
 		return
 	}
@@ -279,9 +279,17 @@ func (r *ImportListTraktUserResource) Update(ctx context.Context, req resource.U
 	// Update ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ImportListTraktUser) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktUserResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, importListTraktUserResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -314,8 +322,7 @@ func (r *ImportListTraktUserResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListTraktUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+importListTraktUserResourceName+": "+req.ID)
+	importImportListState(ctx, r.client, r.auth, importListTraktUserResourceName, importListTraktUserImplementation, req, resp)
 }
 
 func (i *ImportListTraktUser) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerBroadcastheNetResource{}
 	_ resource.ResourceWithImportState = &IndexerBroadcastheNetResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerBroadcastheNetResource{}
 )
 
 func NewIndexerBroadcastheNetResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerBroadcastheNetResource() resource.Resource {
 type IndexerBroadcastheNetResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerBroadcastheNet describes the BroadcastheNet indexer data model.
@@ -184,9 +186,15 @@ func (r *IndexerBroadcastheNetResource) Configure(ctx context.Context, req resou
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerBroadcastheNetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerBroadcastheNet
@@ -202,7 +210,7 @@ func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerBroadcastheNetResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerBroadcastheNetResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -226,7 +234,7 @@ func (r *IndexerBroadcastheNetResource) Read(ctx context.Context, req resource.R
 	// Get IndexerBroadcastheNet current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerBroadcastheNetResourceName, err))
+		helpers.HandleReadError(ctx, indexerBroadcastheNetResourceName, err, resp)
 
 		return
 	}
@@ -250,9 +258,17 @@ func (r *IndexerBroadcastheNetResource) Update(ctx context.Context, req resource
 	// Update IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerBroadcastheNet) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerBroadcastheNetResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerBroadcastheNetResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -285,8 +301,7 @@ func (r *IndexerBroadcastheNetResource) Delete(ctx context.Context, req resource
 }
 
 func (r *IndexerBroadcastheNetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerBroadcastheNetResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerBroadcastheNetResourceName, indexerBroadcastheNetImplementation, req, resp)
 }
 
 func (i *IndexerBroadcastheNet) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
@@ -101,7 +101,7 @@ func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerConfig
 	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerConfigResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerConfigResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -149,10 +149,18 @@ func (r *IndexerConfigResource) Update(ctx context.Context, req resource.UpdateR
 	// Build Update resource
 	request := config.read()
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerConfig) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerConfigResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerConfigResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerFanzubResource{}
 	_ resource.ResourceWithImportState = &IndexerFanzubResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerFanzubResource{}
 )
 
 func NewIndexerFanzubResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerFanzubResource() resource.Resource {
 type IndexerFanzubResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerFanzub describes the Fanzub indexer data model.
@@ -153,9 +155,15 @@ func (r *IndexerFanzubResource) Configure(ctx context.Context, req resource.Conf
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerFanzubResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerFanzub
@@ -171,7 +179,7 @@ func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateR
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFanzubResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerFanzubResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -195,7 +203,7 @@ func (r *IndexerFanzubResource) Read(ctx context.Context, req resource.ReadReque
 	// Get IndexerFanzub current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFanzubResourceName, err))
+		helpers.HandleReadError(ctx, indexerFanzubResourceName, err, resp)
 
 		return
 	}
@@ -219,9 +227,17 @@ func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerFanzub) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFanzubResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerFanzubResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -254,8 +270,7 @@ func (r *IndexerFanzubResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerFanzubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerFanzubResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerFanzubResourceName, indexerFanzubImplementation, req, resp)
 }
 
 func (i *IndexerFanzub) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
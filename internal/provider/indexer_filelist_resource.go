@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerFilelistResource{}
 	_ resource.ResourceWithImportState = &IndexerFilelistResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerFilelistResource{}
 )
 
 func NewIndexerFilelistResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerFilelistResource() resource.Resource {
 type IndexerFilelistResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerFilelist describes the Filelist indexer data model.
@@ -210,9 +212,15 @@ func (r *IndexerFilelistResource) Configure(ctx context.Context, req resource.Co
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerFilelistResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerFilelist
@@ -228,7 +236,7 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerFilelistResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -252,7 +260,7 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 	// Get IndexerFilelist current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFilelistResourceName, err))
+		helpers.HandleReadError(ctx, indexerFilelistResourceName, err, resp)
 
 		return
 	}
@@ -276,9 +284,17 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerFilelist) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerFilelistResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -311,8 +327,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerFilelistResourceName, indexerFilelistImplementation, req, resp)
 }
 
 func (i *IndexerFilelist) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerHdbitsResource{}
 	_ resource.ResourceWithImportState = &IndexerHdbitsResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerHdbitsResource{}
 )
 
 func NewIndexerHdbitsResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerHdbitsResource() resource.Resource {
 type IndexerHdbitsResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerHdbits describes the Hdbits indexer data model.
@@ -192,9 +194,15 @@ func (r *IndexerHdbitsResource) Configure(ctx context.Context, req resource.Conf
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerHdbitsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerHdbits
@@ -210,7 +218,7 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHdbitsResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerHdbitsResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -234,7 +242,7 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 	// Get IndexerHdbits current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHdbitsResourceName, err))
+		helpers.HandleReadError(ctx, indexerHdbitsResourceName, err, resp)
 
 		return
 	}
@@ -258,9 +266,17 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerHdbits) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHdbitsResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerHdbitsResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -293,8 +309,7 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerHdbitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerHdbitsResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerHdbitsResourceName, indexerHdbitsImplementation, req, resp)
 }
 
 func (i *IndexerHdbits) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
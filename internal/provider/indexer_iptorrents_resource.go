@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerIptorrentsResource{}
 	_ resource.ResourceWithImportState = &IndexerIptorrentsResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerIptorrentsResource{}
 )
 
 func NewIndexerIptorrentsResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerIptorrentsResource() resource.Resource {
 type IndexerIptorrentsResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerIptorrents describes the Iptorrents indexer data model.
@@ -176,9 +178,15 @@ func (r *IndexerIptorrentsResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerIptorrentsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerIptorrents
@@ -194,7 +202,7 @@ func (r *IndexerIptorrentsResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerIptorrentsResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -218,7 +226,7 @@ func (r *IndexerIptorrentsResource) Read(ctx context.Context, req resource.ReadR
 	// Get IndexerIptorrents current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerIptorrentsResourceName, err))
+		helpers.HandleReadError(ctx, indexerIptorrentsResourceName, err, resp)
 
 		return
 	}
@@ -242,9 +250,17 @@ func (r *IndexerIptorrentsResource) Update(ctx context.Context, req resource.Upd
 	// Update IndexerIptorrents
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerIptorrents) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerIptorrentsResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerIptorrentsResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -277,8 +293,7 @@ func (r *IndexerIptorrentsResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerIptorrentsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerIptorrentsResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerIptorrentsResourceName, indexerIptorrentsImplementation, req, resp)
 }
 
 func (i *IndexerIptorrents) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
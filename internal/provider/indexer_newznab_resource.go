@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerNewznabResource{}
 	_ resource.ResourceWithImportState = &IndexerNewznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerNewznabResource{}
 )
 
 func NewIndexerNewznabResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerNewznabResource() resource.Resource {
 type IndexerNewznabResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerNewznab describes the Newznab indexer data model.
@@ -196,9 +198,15 @@ func (r *IndexerNewznabResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerNewznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerNewznab
@@ -214,7 +222,7 @@ func (r *IndexerNewznabResource) Create(ctx context.Context, req resource.Create
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNewznabResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerNewznabResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -238,7 +246,7 @@ func (r *IndexerNewznabResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get IndexerNewznab current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNewznabResourceName, err))
+		helpers.HandleReadError(ctx, indexerNewznabResourceName, err, resp)
 
 		return
 	}
@@ -262,9 +270,17 @@ func (r *IndexerNewznabResource) Update(ctx context.Context, req resource.Update
 	// Update IndexerNewznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerNewznab) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNewznabResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerNewznabResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -297,8 +313,7 @@ func (r *IndexerNewznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerNewznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerNewznabResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerNewznabResourceName, indexerNewznabImplementation, req, resp)
 }
 
 func (i *IndexerNewznab) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
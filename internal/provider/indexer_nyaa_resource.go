@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerNyaaResource{}
 	_ resource.ResourceWithImportState = &IndexerNyaaResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerNyaaResource{}
 )
 
 func NewIndexerNyaaResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerNyaaResource() resource.Resource {
 type IndexerNyaaResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerNyaa describes the Nyaa indexer data model.
@@ -192,9 +194,15 @@ func (r *IndexerNyaaResource) Configure(ctx context.Context, req resource.Config
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerNyaaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerNyaa
@@ -210,7 +218,7 @@ func (r *IndexerNyaaResource) Create(ctx context.Context, req resource.CreateReq
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerNyaaResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerNyaaResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -234,7 +242,7 @@ func (r *IndexerNyaaResource) Read(ctx context.Context, req resource.ReadRequest
 	// Get IndexerNyaa current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerNyaaResourceName, err))
+		helpers.HandleReadError(ctx, indexerNyaaResourceName, err, resp)
 
 		return
 	}
@@ -258,9 +266,17 @@ func (r *IndexerNyaaResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update IndexerNyaa
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerNyaa) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerNyaaResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerNyaaResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -293,8 +309,7 @@ func (r *IndexerNyaaResource) Delete(ctx context.Context, req resource.DeleteReq
 }
 
 func (r *IndexerNyaaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerNyaaResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerNyaaResourceName, indexerNyaaImplementation, req, resp)
 }
 
 func (i *IndexerNyaa) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
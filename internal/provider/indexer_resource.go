@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -25,6 +26,7 @@ const indexerResourceName = "indexer"
 var (
 	_ resource.Resource                = &IndexerResource{}
 	_ resource.ResourceWithImportState = &IndexerResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerResource{}
 )
 
 var indexerFields = helpers.Fields{
@@ -45,6 +47,7 @@ func NewIndexerResource() resource.Resource {
 type IndexerResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // Indexer describes the indexer data model.
@@ -283,9 +286,15 @@ func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureR
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *Indexer
@@ -301,7 +310,7 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -329,7 +338,7 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// Get Indexer current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerResourceName, err))
+		helpers.HandleReadError(ctx, indexerResourceName, err, resp)
 
 		return
 	}
@@ -357,9 +366,17 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *Indexer) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -396,8 +413,7 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerResourceName, "", req, resp)
 }
 
 func (i *Indexer) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
@@ -449,3 +465,21 @@ func (i *Indexer) writeSensitive(indexer *Indexer) {
 		i.APIKey = indexer.APIKey
 	}
 }
+
+// importIndexerState imports a indexer by ID or by name, matching the implementation if set.
+func importIndexerState(ctx context.Context, client *sonarr.APIClient, auth context.Context, name, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, name, helpers.NameImportKey,
+		func() ([]sonarr.IndexerResource, error) {
+			response, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.IndexerResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value) && (implementation == "" || item.GetImplementation() == implementation)
+		},
+		func(item *sonarr.IndexerResource) int32 {
+			return item.GetId()
+		},
+	)
+	tflog.Trace(ctx, "imported "+name+": "+req.ID)
+}
@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorrentRssResource{}
 	_ resource.ResourceWithImportState = &IndexerTorrentRssResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorrentRssResource{}
 )
 
 func NewIndexerTorrentRssResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerTorrentRssResource() resource.Resource {
 type IndexerTorrentRssResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerTorrentRss describes the TorrentRss indexer data model.
@@ -192,9 +194,15 @@ func (r *IndexerTorrentRssResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerTorrentRssResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorrentRss
@@ -210,7 +218,7 @@ func (r *IndexerTorrentRssResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentRssResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerTorrentRssResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -234,7 +242,7 @@ func (r *IndexerTorrentRssResource) Read(ctx context.Context, req resource.ReadR
 	// Get IndexerTorrentRss current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentRssResourceName, err))
+		helpers.HandleReadError(ctx, indexerTorrentRssResourceName, err, resp)
 
 		return
 	}
@@ -258,9 +266,17 @@ func (r *IndexerTorrentRssResource) Update(ctx context.Context, req resource.Upd
 	// Update IndexerTorrentRss
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerTorrentRss) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentRssResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerTorrentRssResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -293,8 +309,7 @@ func (r *IndexerTorrentRssResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *IndexerTorrentRssResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerTorrentRssResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerTorrentRssResourceName, indexerTorrentRssImplementation, req, resp)
 }
 
 func (i *IndexerTorrentRss) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
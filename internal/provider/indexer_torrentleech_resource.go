@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorrentleechResource{}
 	_ resource.ResourceWithImportState = &IndexerTorrentleechResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorrentleechResource{}
 )
 
 func NewIndexerTorrentleechResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerTorrentleechResource() resource.Resource {
 type IndexerTorrentleechResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerTorrentleech describes the Torrentleech indexer data model.
@@ -185,9 +187,15 @@ func (r *IndexerTorrentleechResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerTorrentleechResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerTorrentleechResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorrentleech
@@ -203,7 +211,7 @@ func (r *IndexerTorrentleechResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorrentleechResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerTorrentleechResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -227,7 +235,7 @@ func (r *IndexerTorrentleechResource) Read(ctx context.Context, req resource.Rea
 	// Get IndexerTorrentleech current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorrentleechResourceName, err))
+		helpers.HandleReadError(ctx, indexerTorrentleechResourceName, err, resp)
 
 		return
 	}
@@ -251,9 +259,17 @@ func (r *IndexerTorrentleechResource) Update(ctx context.Context, req resource.U
 	// Update IndexerTorrentleech
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerTorrentleech) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorrentleechResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerTorrentleechResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -286,8 +302,7 @@ func (r *IndexerTorrentleechResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *IndexerTorrentleechResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerTorrentleechResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerTorrentleechResourceName, indexerTorrentleechImplementation, req, resp)
 }
 
 func (i *IndexerTorrentleech) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
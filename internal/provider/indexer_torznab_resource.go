@@ -27,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &IndexerTorznabResource{}
 	_ resource.ResourceWithImportState = &IndexerTorznabResource{}
+	_ resource.ResourceWithModifyPlan  = &IndexerTorznabResource{}
 )
 
 func NewIndexerTorznabResource() resource.Resource {
@@ -37,6 +38,7 @@ func NewIndexerTorznabResource() resource.Resource {
 type IndexerTorznabResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // IndexerTorznab describes the Torznab indexer data model.
@@ -227,9 +229,15 @@ func (r *IndexerTorznabResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *IndexerTorznabResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var indexer *IndexerTorznab
@@ -245,7 +253,7 @@ func (r *IndexerTorznabResource) Create(ctx context.Context, req resource.Create
 
 	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerTorznabResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, indexerTorznabResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -269,7 +277,7 @@ func (r *IndexerTorznabResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get IndexerTorznab current value
 	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerTorznabResourceName, err))
+		helpers.HandleReadError(ctx, indexerTorznabResourceName, err, resp)
 
 		return
 	}
@@ -293,9 +301,17 @@ func (r *IndexerTorznabResource) Update(ctx context.Context, req resource.Update
 	// Update IndexerTorznab
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *IndexerTorznab) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerTorznabResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, indexerTorznabResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -328,8 +344,7 @@ func (r *IndexerTorznabResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *IndexerTorznabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+indexerTorznabResourceName+": "+req.ID)
+	importIndexerState(ctx, r.client, r.auth, indexerTorznabResourceName, indexerTorznabImplementation, req, resp)
 }
 
 func (i *IndexerTorznab) write(ctx context.Context, indexer *sonarr.IndexerResource, diags *diag.Diagnostics) {
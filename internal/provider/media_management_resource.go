@@ -185,7 +185,7 @@ func (r *MediaManagementResource) Create(ctx context.Context, req resource.Creat
 	// Create new MediaManagement
 	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(r.auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, mediaManagementResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, mediaManagementResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -233,10 +233,18 @@ func (r *MediaManagementResource) Update(ctx context.Context, req resource.Updat
 	// Build Update resource
 	request := management.read()
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *MediaManagement) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update MediaManagement
-	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(r.auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
+	response, _, err := r.client.MediaManagementConfigAPI.UpdateMediaManagementConfig(auth, strconv.Itoa(int(request.GetId()))).MediaManagementConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, mediaManagementResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, mediaManagementResourceName, err, &resp.Diagnostics)
 
 		return
 	}
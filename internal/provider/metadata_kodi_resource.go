@@ -163,7 +163,7 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, metadataKodiResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -187,7 +187,7 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	// Get MetadataKodi current value
 	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
+		helpers.HandleReadError(ctx, metadataKodiResourceName, err, resp)
 
 		return
 	}
@@ -211,9 +211,17 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *MetadataKodi) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataKodiResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, metadataKodiResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -246,8 +254,7 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+metadataKodiResourceName+": "+req.ID)
+	importMetadataState(ctx, r.client, r.auth, metadataKodiResourceName, metadataKodiImplementation, req, resp)
 }
 
 func (m *MetadataKodi) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
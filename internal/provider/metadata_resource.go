@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -168,7 +169,7 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, metadataResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -195,7 +196,7 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Get Metadata current value
 	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
+		helpers.HandleReadError(ctx, metadataResourceName, err, resp)
 
 		return
 	}
@@ -222,9 +223,17 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *Metadata) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, metadataResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -260,8 +269,7 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *MetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+metadataResourceName+": "+req.ID)
+	importMetadataState(ctx, r.client, r.auth, metadataResourceName, "", req, resp)
 }
 
 func (m *Metadata) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
@@ -289,3 +297,21 @@ func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Me
 
 	return metadata
 }
+
+// importMetadataState imports a metadata by ID or by name, matching the implementation if set.
+func importMetadataState(ctx context.Context, client *sonarr.APIClient, auth context.Context, name, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, name, helpers.NameImportKey,
+		func() ([]sonarr.MetadataResource, error) {
+			response, _, err := client.MetadataAPI.ListMetadata(auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.MetadataResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value) && (implementation == "" || item.GetImplementation() == implementation)
+		},
+		func(item *sonarr.MetadataResource) int32 {
+			return item.GetId()
+		},
+	)
+	tflog.Trace(ctx, "imported "+name+": "+req.ID)
+}
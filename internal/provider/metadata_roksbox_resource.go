@@ -149,7 +149,7 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, metadataRoksboxResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -173,7 +173,7 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	// Get MetadataRoksbox current value
 	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
+		helpers.HandleReadError(ctx, metadataRoksboxResourceName, err, resp)
 
 		return
 	}
@@ -197,9 +197,17 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *MetadataRoksbox) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataRoksboxResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, metadataRoksboxResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -232,8 +240,7 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *MetadataRoksboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+metadataRoksboxResourceName+": "+req.ID)
+	importMetadataState(ctx, r.client, r.auth, metadataRoksboxResourceName, metadataRoksboxImplementation, req, resp)
 }
 
 func (m *MetadataRoksbox) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
@@ -149,7 +149,7 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, metadataWdtvResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -173,7 +173,7 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	// Get MetadataWdtv current value
 	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
+		helpers.HandleReadError(ctx, metadataWdtvResourceName, err, resp)
 
 		return
 	}
@@ -197,9 +197,17 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *MetadataWdtv) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataWdtvResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, metadataWdtvResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -232,8 +240,7 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataWdtvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+metadataWdtvResourceName+": "+req.ID)
+	importMetadataState(ctx, r.client, r.auth, metadataWdtvResourceName, metadataWdtvImplementation, req, resp)
 }
 
 func (m *MetadataWdtv) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
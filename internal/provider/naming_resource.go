@@ -146,7 +146,7 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	// Create new Naming
 	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, namingResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, namingResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -194,10 +194,18 @@ func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Build Update resource
 	request := naming.read()
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *Naming) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, namingResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, namingResourceName, err, &resp.Diagnostics)
 
 		return
 	}
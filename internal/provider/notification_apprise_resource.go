@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
 	_ resource.ResourceWithImportState = &NotificationAppriseResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationAppriseResource{}
 )
 
 func NewNotificationAppriseResource() resource.Resource {
@@ -36,8 +38,10 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationApprise describes the notification data model.
@@ -262,9 +266,18 @@ func (r *NotificationAppriseResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationAppriseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationApprise
@@ -280,7 +293,7 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationAppriseResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -304,7 +317,7 @@ func (r *NotificationAppriseResource) Read(ctx context.Context, req resource.Rea
 	// Get NotificationApprise current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationAppriseResourceName, err))
+		helpers.HandleReadError(ctx, notificationAppriseResourceName, err, resp)
 
 		return
 	}
@@ -328,9 +341,17 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationApprise) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationAppriseResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -363,8 +384,7 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationAppriseResourceName, notificationAppriseImplementation, req, resp)
 }
 
 func (n *NotificationApprise) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
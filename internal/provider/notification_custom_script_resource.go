@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationCustomScriptResource{}
 	_ resource.ResourceWithImportState = &NotificationCustomScriptResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationCustomScriptResource{}
 )
 
 func NewNotificationCustomScriptResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationCustomScriptResource() resource.Resource {
 
 // NotificationCustomScriptResource defines the notification implementation.
 type NotificationCustomScriptResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationCustomScript describes the notification data model.
@@ -222,9 +226,18 @@ func (r *NotificationCustomScriptResource) Configure(ctx context.Context, req re
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationCustomScriptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationCustomScript
@@ -240,7 +253,7 @@ func (r *NotificationCustomScriptResource) Create(ctx context.Context, req resou
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationCustomScriptResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -264,7 +277,7 @@ func (r *NotificationCustomScriptResource) Read(ctx context.Context, req resourc
 	// Get NotificationCustomScript current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationCustomScriptResourceName, err))
+		helpers.HandleReadError(ctx, notificationCustomScriptResourceName, err, resp)
 
 		return
 	}
@@ -288,9 +301,17 @@ func (r *NotificationCustomScriptResource) Update(ctx context.Context, req resou
 	// Update NotificationCustomScript
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationCustomScript) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationCustomScriptResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationCustomScriptResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -323,8 +344,7 @@ func (r *NotificationCustomScriptResource) Delete(ctx context.Context, req resou
 }
 
 func (r *NotificationCustomScriptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationCustomScriptResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationCustomScriptResourceName, notificationCustomScriptImplementation, req, resp)
 }
 
 func (n *NotificationCustomScript) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
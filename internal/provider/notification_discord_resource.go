@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationDiscordResource{}
 	_ resource.ResourceWithImportState = &NotificationDiscordResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationDiscordResource{}
 )
 
 func NewNotificationDiscordResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationDiscord describes the notification data model.
@@ -256,9 +260,18 @@ func (r *NotificationDiscordResource) Configure(ctx context.Context, req resourc
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationDiscordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationDiscord
@@ -274,7 +287,7 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationDiscordResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -298,7 +311,7 @@ func (r *NotificationDiscordResource) Read(ctx context.Context, req resource.Rea
 	// Get NotificationDiscord current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationDiscordResourceName, err))
+		helpers.HandleReadError(ctx, notificationDiscordResourceName, err, resp)
 
 		return
 	}
@@ -322,9 +335,17 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationDiscord) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationDiscordResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -357,8 +378,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationDiscordResourceName, notificationDiscordImplementation, req, resp)
 }
 
 func (n *NotificationDiscord) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmailResource{}
 	_ resource.ResourceWithImportState = &NotificationEmailResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmailResource{}
 )
 
 func NewNotificationEmailResource() resource.Resource {
@@ -36,8 +38,10 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationEmail describes the notification data model.
@@ -277,9 +281,18 @@ func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationEmailResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationEmailResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmail
@@ -295,7 +308,7 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationEmailResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -319,7 +332,7 @@ func (r *NotificationEmailResource) Read(ctx context.Context, req resource.ReadR
 	// Get NotificationEmail current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmailResourceName, err))
+		helpers.HandleReadError(ctx, notificationEmailResourceName, err, resp)
 
 		return
 	}
@@ -343,9 +356,17 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationEmail) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationEmailResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -378,8 +399,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationEmailResourceName, notificationEmailImplementation, req, resp)
 }
 
 func (n *NotificationEmail) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationEmbyResource{}
 	_ resource.ResourceWithImportState = &NotificationEmbyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationEmbyResource{}
 )
 
 func NewNotificationEmbyResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationEmbyResource() resource.Resource {
 
 // NotificationEmbyResource defines the notification implementation.
 type NotificationEmbyResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationEmby describes the notification data model.
@@ -246,9 +250,18 @@ func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationEmbyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationEmby
@@ -264,7 +277,7 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationEmbyResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -288,7 +301,7 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	// Get NotificationEmby current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
+		helpers.HandleReadError(ctx, notificationEmbyResourceName, err, resp)
 
 		return
 	}
@@ -312,9 +325,17 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationEmby) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationEmbyResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -347,8 +368,7 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationEmbyResourceName, notificationEmbyImplementation, req, resp)
 }
 
 func (n *NotificationEmby) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
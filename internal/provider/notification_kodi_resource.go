@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationKodiResource{}
 	_ resource.ResourceWithImportState = &NotificationKodiResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationKodiResource{}
 )
 
 func NewNotificationKodiResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationKodiResource() resource.Resource {
 
 // NotificationKodiResource defines the notification implementation.
 type NotificationKodiResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationKodi describes the notification data model.
@@ -286,9 +290,18 @@ func (r *NotificationKodiResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationKodiResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationKodiResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationKodi
@@ -304,7 +317,7 @@ func (r *NotificationKodiResource) Create(ctx context.Context, req resource.Crea
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationKodiResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationKodiResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -328,7 +341,7 @@ func (r *NotificationKodiResource) Read(ctx context.Context, req resource.ReadRe
 	// Get NotificationKodi current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationKodiResourceName, err))
+		helpers.HandleReadError(ctx, notificationKodiResourceName, err, resp)
 
 		return
 	}
@@ -352,9 +365,17 @@ func (r *NotificationKodiResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationKodi
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationKodi) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationKodiResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationKodiResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -387,8 +408,7 @@ func (r *NotificationKodiResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationKodiResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationKodiResourceName, notificationKodiImplementation, req, resp)
 }
 
 func (n *NotificationKodi) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
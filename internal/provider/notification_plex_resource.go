@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationPlexResource{}
 	_ resource.ResourceWithImportState = &NotificationPlexResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationPlexResource{}
 )
 
 func NewNotificationPlexResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationPlexResource() resource.Resource {
 
 // NotificationPlexResource defines the notification implementation.
 type NotificationPlexResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationPlex describes the notification data model.
@@ -206,9 +210,18 @@ func (r *NotificationPlexResource) Configure(ctx context.Context, req resource.C
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationPlexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationPlexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationPlex
@@ -224,7 +237,7 @@ func (r *NotificationPlexResource) Create(ctx context.Context, req resource.Crea
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPlexResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationPlexResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -248,7 +261,7 @@ func (r *NotificationPlexResource) Read(ctx context.Context, req resource.ReadRe
 	// Get NotificationPlex current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPlexResourceName, err))
+		helpers.HandleReadError(ctx, notificationPlexResourceName, err, resp)
 
 		return
 	}
@@ -272,9 +285,17 @@ func (r *NotificationPlexResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationPlex
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationPlex) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPlexResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationPlexResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -307,8 +328,7 @@ func (r *NotificationPlexResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationPlexResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationPlexResourceName, notificationPlexImplementation, req, resp)
 }
 
 func (n *NotificationPlex) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
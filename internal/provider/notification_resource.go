@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -25,6 +26,7 @@ const notificationResourceName = "notification"
 var (
 	_ resource.Resource                = &NotificationResource{}
 	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationResource{}
 )
 
 var notificationFields = helpers.Fields{
@@ -44,6 +46,7 @@ func NewNotificationResource() resource.Resource {
 type NotificationResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
 }
 
 // Notification describes the notification data model.
@@ -729,9 +732,15 @@ func (r *NotificationResource) Configure(ctx context.Context, req resource.Confi
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
 	}
 }
 
+func (r *NotificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+}
+
 func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *Notification
@@ -747,7 +756,7 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -775,7 +784,7 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	// Get Notification current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationResourceName, err))
+		helpers.HandleReadError(ctx, notificationResourceName, err, resp)
 
 		return
 	}
@@ -803,9 +812,17 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *Notification) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -842,8 +859,7 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationResourceName, "", req, resp)
 }
 
 func (n *Notification) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
@@ -956,3 +972,21 @@ func (n *Notification) writeSensitive(notification *Notification) {
 		n.AuthPassword = notification.AuthPassword
 	}
 }
+
+// importNotificationState imports a notification by ID or by name, matching the implementation if set.
+func importNotificationState(ctx context.Context, client *sonarr.APIClient, auth context.Context, name, implementation string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, name, helpers.NameImportKey,
+		func() ([]sonarr.NotificationResource, error) {
+			response, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.NotificationResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value) && (implementation == "" || item.GetImplementation() == implementation)
+		},
+		func(item *sonarr.NotificationResource) int32 {
+			return item.GetId()
+		},
+	)
+	tflog.Trace(ctx, "imported "+name+": "+req.ID)
+}
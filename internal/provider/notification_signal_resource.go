@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationSignalResource{}
 	_ resource.ResourceWithImportState = &NotificationSignalResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSignalResource{}
 )
 
 func NewNotificationSignalResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationSignalResource() resource.Resource {
 
 // NotificationSignalResource defines the notification implementation.
 type NotificationSignalResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationSignal describes the notification data model.
@@ -254,9 +258,18 @@ func (r *NotificationSignalResource) Configure(ctx context.Context, req resource
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationSignalResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationSignalResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSignal
@@ -272,7 +285,7 @@ func (r *NotificationSignalResource) Create(ctx context.Context, req resource.Cr
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSignalResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationSignalResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -296,7 +309,7 @@ func (r *NotificationSignalResource) Read(ctx context.Context, req resource.Read
 	// Get NotificationSignal current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSignalResourceName, err))
+		helpers.HandleReadError(ctx, notificationSignalResourceName, err, resp)
 
 		return
 	}
@@ -320,9 +333,17 @@ func (r *NotificationSignalResource) Update(ctx context.Context, req resource.Up
 	// Update NotificationSignal
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationSignal) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSignalResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationSignalResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -355,8 +376,7 @@ func (r *NotificationSignalResource) Delete(ctx context.Context, req resource.De
 }
 
 func (r *NotificationSignalResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationSignalResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationSignalResourceName, notificationSignalImplementation, req, resp)
 }
 
 func (n *NotificationSignal) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationSynologyResource{}
 	_ resource.ResourceWithImportState = &NotificationSynologyResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationSynologyResource{}
 )
 
 func NewNotificationSynologyResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationSynologyResource() resource.Resource {
 
 // NotificationSynologyResource defines the notification implementation.
 type NotificationSynologyResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationSynology describes the notification data model.
@@ -175,9 +179,18 @@ func (r *NotificationSynologyResource) Configure(ctx context.Context, req resour
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationSynologyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationSynology
@@ -193,7 +206,7 @@ func (r *NotificationSynologyResource) Create(ctx context.Context, req resource.
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationSynologyResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationSynologyResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -217,7 +230,7 @@ func (r *NotificationSynologyResource) Read(ctx context.Context, req resource.Re
 	// Get NotificationSynology current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSynologyResourceName, err))
+		helpers.HandleReadError(ctx, notificationSynologyResourceName, err, resp)
 
 		return
 	}
@@ -241,9 +254,17 @@ func (r *NotificationSynologyResource) Update(ctx context.Context, req resource.
 	// Update NotificationSynology
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationSynology) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationSynologyResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationSynologyResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -276,8 +297,7 @@ func (r *NotificationSynologyResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *NotificationSynologyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationSynologyResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationSynologyResourceName, notificationSynologyImplementation, req, resp)
 }
 
 func (n *NotificationSynology) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
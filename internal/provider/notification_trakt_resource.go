@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -26,6 +27,7 @@ const (
 var (
 	_ resource.Resource                = &NotificationTraktResource{}
 	_ resource.ResourceWithImportState = &NotificationTraktResource{}
+	_ resource.ResourceWithModifyPlan  = &NotificationTraktResource{}
 )
 
 func NewNotificationTraktResource() resource.Resource {
@@ -34,8 +36,10 @@ func NewNotificationTraktResource() resource.Resource {
 
 // NotificationTraktResource defines the notification implementation.
 type NotificationTraktResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
 }
 
 // NotificationTrakt describes the notification data model.
@@ -190,9 +194,18 @@ func (r *NotificationTraktResource) Configure(ctx context.Context, req resource.
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
 	}
 }
 
+func (r *NotificationTraktResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateTags(ctx, req.Plan, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("on_import_complete"), r.version, helpers.OnImportCompleteVersion, &resp.Diagnostics)
+}
+
 func (r *NotificationTraktResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var notification *NotificationTrakt
@@ -208,7 +221,7 @@ func (r *NotificationTraktResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationTraktResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, notificationTraktResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -232,7 +245,7 @@ func (r *NotificationTraktResource) Read(ctx context.Context, req resource.ReadR
 	// Get NotificationTrakt current value
 	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationTraktResourceName, err))
+		helpers.HandleReadError(ctx, notificationTraktResourceName, err, resp)
 
 		return
 	}
@@ -256,9 +269,17 @@ func (r *NotificationTraktResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationTrakt
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *NotificationTrakt) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationTraktResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, notificationTraktResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -291,8 +312,7 @@ func (r *NotificationTraktResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationTraktResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
-	tflog.Trace(ctx, "imported "+notificationTraktResourceName+": "+req.ID)
+	importNotificationState(ctx, r.client, r.auth, notificationTraktResourceName, notificationTraktImplementation, req, resp)
 }
 
 func (n *NotificationTrakt) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
@@ -0,0 +1,700 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testserver"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// testOffline drives the provider through the plugin protocol against the fake Sonarr,
+// so that the main workflows are covered without the Terraform CLI.
+type testOffline struct {
+	t       *testing.T
+	fake    *testserver.Server
+	server  tfprotov6.ProviderServer
+	schemas map[string]*tfprotov6.Schema
+	config  map[string]tftypes.Value
+	// private keeps the private state of each resource type, as Terraform does
+	private map[string][]byte
+}
+
+func newTestOffline(t *testing.T, config map[string]tftypes.Value) *testOffline {
+	t.Helper()
+
+	fake := testserver.New()
+	t.Cleanup(fake.Close)
+
+	if config == nil {
+		config = make(map[string]tftypes.Value)
+	}
+
+	config["url"] = tftypes.NewValue(tftypes.String, fake.URL)
+	config["api_key"] = tftypes.NewValue(tftypes.String, testserver.APIKey)
+
+	o := &testOffline{
+		t:       t,
+		fake:    fake,
+		config:  config,
+		private: make(map[string][]byte),
+	}
+	o.start()
+
+	return o
+}
+
+// start configures a new provider, as the Terraform CLI does for each command.
+func (o *testOffline) start() {
+	o.t.Helper()
+
+	ctx := context.Background()
+	o.server = providerserver.NewProtocol6(New("test")())()
+
+	schemas, err := o.server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	testFatal(o.t, err)
+	testNoErrors(o.t, schemas.Diagnostics)
+
+	o.schemas = schemas.ResourceSchemas
+
+	configured, err := o.server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: o.dynamicValue(schemas.Provider, testObject(schemas.Provider, o.config)),
+	})
+	testFatal(o.t, err)
+	testNoErrors(o.t, configured.Diagnostics)
+}
+
+// testPlan is a planned change, applied later as within a single Terraform run.
+type testPlan struct {
+	planned  *tfprotov6.PlanResourceChangeResponse
+	prior    tftypes.Value
+	config   tftypes.Value
+	typeName string
+}
+
+// apply plans and applies the configuration, starting from the prior state (null on create).
+func (o *testOffline) apply(typeName string, prior tftypes.Value, values map[string]tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	o.t.Helper()
+	o.start()
+
+	if prior.Type() == nil {
+		prior = tftypes.NewValue(o.schemas[typeName].ValueType(), nil)
+	}
+
+	plan, diags := o.plan(typeName, prior, values)
+	if plan == nil {
+		return prior, diags
+	}
+
+	return o.applyPlan(plan)
+}
+
+// plan validates and plans the configuration with the running provider, returning nil on errors.
+func (o *testOffline) plan(typeName string, prior tftypes.Value, values map[string]tftypes.Value) (*testPlan, []*tfprotov6.Diagnostic) {
+	o.t.Helper()
+
+	ctx := context.Background()
+	schema := o.schemas[typeName]
+	config := testObject(schema, values)
+
+	if prior.Type() == nil {
+		prior = tftypes.NewValue(schema.ValueType(), nil)
+	}
+
+	validated, err := o.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: typeName,
+		Config:   o.dynamicValue(schema, config),
+	})
+	testFatal(o.t, err)
+
+	if testHasError(validated.Diagnostics) {
+		return nil, validated.Diagnostics
+	}
+
+	planned, err := o.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       o.dynamicValue(schema, prior),
+		ProposedNewState: o.dynamicValue(schema, proposedNewState(schema, prior, config)),
+		Config:           o.dynamicValue(schema, config),
+		PriorPrivate:     o.private[typeName],
+	})
+	testFatal(o.t, err)
+
+	if testHasError(planned.Diagnostics) {
+		return nil, planned.Diagnostics
+	}
+
+	if len(planned.RequiresReplace) > 0 {
+		o.t.Fatalf("replacing resources is not supported offline: %v", planned.RequiresReplace)
+	}
+
+	return &testPlan{planned: planned, prior: prior, config: config, typeName: typeName}, planned.Diagnostics
+}
+
+// applyPlan applies a planned change with the running provider.
+func (o *testOffline) applyPlan(plan *testPlan) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	o.t.Helper()
+
+	schema := o.schemas[plan.typeName]
+
+	applied, err := o.server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       plan.typeName,
+		PriorState:     o.dynamicValue(schema, plan.prior),
+		PlannedState:   plan.planned.PlannedState,
+		Config:         o.dynamicValue(schema, plan.config),
+		PlannedPrivate: plan.planned.PlannedPrivate,
+	})
+	testFatal(o.t, err)
+
+	if !testHasError(applied.Diagnostics) {
+		o.private[plan.typeName] = applied.Private
+	}
+
+	return o.value(schema, applied.NewState), applied.Diagnostics
+}
+
+// read refreshes the state, returning null when the resource is gone.
+func (o *testOffline) read(typeName string, state tftypes.Value) tftypes.Value {
+	o.t.Helper()
+	o.start()
+
+	schema := o.schemas[typeName]
+
+	read, err := o.server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: o.dynamicValue(schema, state),
+		Private:      o.private[typeName],
+	})
+	testFatal(o.t, err)
+	testNoErrors(o.t, read.Diagnostics)
+
+	o.private[typeName] = read.Private
+
+	return o.value(schema, read.NewState)
+}
+
+// destroy deletes the resource, as planned for a removed configuration.
+func (o *testOffline) destroy(typeName string, state tftypes.Value) {
+	o.t.Helper()
+	o.start()
+
+	schema := o.schemas[typeName]
+	null := tftypes.NewValue(schema.ValueType(), nil)
+
+	applied, err := o.server.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:     typeName,
+		PriorState:   o.dynamicValue(schema, state),
+		PlannedState: o.dynamicValue(schema, null),
+		Config:       o.dynamicValue(schema, null),
+	})
+	testFatal(o.t, err)
+	testNoErrors(o.t, applied.Diagnostics)
+}
+
+// schemaType returns the type of a top level attribute of the resource.
+func (o *testOffline) schemaType(typeName, name string) tftypes.Type {
+	objectType, _ := o.schemas[typeName].ValueType().(tftypes.Object)
+
+	return objectType.AttributeTypes[name]
+}
+
+// rootFolder creates a root folder, since the fake Sonarr starts without any.
+func (o *testOffline) rootFolder(folder string) {
+	o.t.Helper()
+
+	request := sonarr.NewRootFolderResource()
+	request.SetPath(folder)
+
+	_, _, err := o.client().RootFolderAPI.CreateRootFolder(context.Background()).RootFolderResource(*request).Execute()
+	testFatal(o.t, err)
+}
+
+func (o *testOffline) client() *sonarr.APIClient {
+	config := sonarr.NewConfiguration()
+	config.AddDefaultHeader("X-Api-Key", testserver.APIKey)
+	config.Servers[0].URL = o.fake.URL
+
+	return sonarr.NewAPIClient(config)
+}
+
+func (o *testOffline) dynamicValue(schema *tfprotov6.Schema, value tftypes.Value) *tfprotov6.DynamicValue {
+	o.t.Helper()
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(schema.ValueType(), value)
+	testFatal(o.t, err)
+
+	return &dynamicValue
+}
+
+func (o *testOffline) value(schema *tfprotov6.Schema, dynamicValue *tfprotov6.DynamicValue) tftypes.Value {
+	o.t.Helper()
+
+	value, err := dynamicValue.Unmarshal(schema.ValueType())
+	testFatal(o.t, err)
+
+	return value
+}
+
+// testObject builds a configuration of the schema, leaving the missing attributes null.
+func testObject(schema *tfprotov6.Schema, values map[string]tftypes.Value) tftypes.Value {
+	objectType, _ := schema.ValueType().(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+
+			continue
+		}
+
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+// proposedNewState mimics Terraform, keeping the prior value of the computed attributes not configured.
+func proposedNewState(schema *tfprotov6.Schema, prior, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() {
+		return config
+	}
+
+	var priorAttributes, configAttributes map[string]tftypes.Value
+
+	_ = prior.As(&priorAttributes)
+	_ = config.As(&configAttributes)
+
+	// the attributes are shared with the configuration, which must not change
+	proposed := make(map[string]tftypes.Value, len(configAttributes))
+	for name, value := range configAttributes {
+		proposed[name] = value
+	}
+
+	for _, attribute := range schema.Block.Attributes {
+		if attribute.Computed && configAttributes[attribute.Name].IsNull() {
+			proposed[attribute.Name] = priorAttributes[attribute.Name]
+		}
+	}
+
+	return tftypes.NewValue(config.Type(), proposed)
+}
+
+func testAttribute(t *testing.T, value tftypes.Value, name string) interface{} {
+	t.Helper()
+
+	var attributes map[string]tftypes.Value
+
+	testFatal(t, value.As(&attributes))
+
+	attribute := attributes[name]
+	if attribute.IsNull() {
+		return nil
+	}
+
+	switch {
+	case attribute.Type().Is(tftypes.String):
+		var s string
+
+		_ = attribute.As(&s)
+
+		return s
+	case attribute.Type().Is(tftypes.Bool):
+		var b bool
+
+		_ = attribute.As(&b)
+
+		return b
+	case attribute.Type().Is(tftypes.Number):
+		n := new(big.Float)
+
+		_ = attribute.As(&n)
+		i, _ := n.Int64()
+
+		return i
+	}
+
+	return attribute
+}
+
+func testFatal(t *testing.T, err error) {
+	t.Helper()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testHasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+func testNoErrors(t *testing.T, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s", d.Summary, d.Detail)
+		}
+	}
+}
+
+func testErrorSummaries(diags []*tfprotov6.Diagnostic) string {
+	summaries := make([]string, 0, len(diags))
+	for _, d := range diags {
+		summaries = append(summaries, d.Summary+": "+d.Detail)
+	}
+
+	return strings.Join(summaries, "\n")
+}
+
+func TestOfflineTagResource(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+
+	state, diags := o.apply("sonarr_tag", tftypes.Value{}, map[string]tftypes.Value{
+		"label": tftypes.NewValue(tftypes.String, "offline"),
+	})
+	testNoErrors(t, diags)
+	assert.Equal(t, "offline", testAttribute(t, state, "label"))
+	assert.Equal(t, int64(1), testAttribute(t, state, "id"))
+
+	state, diags = o.apply("sonarr_tag", state, map[string]tftypes.Value{
+		"label": tftypes.NewValue(tftypes.String, "updated"),
+	})
+	testNoErrors(t, diags)
+	assert.Equal(t, "updated", testAttribute(t, o.read("sonarr_tag", state), "label"))
+
+	o.destroy("sonarr_tag", state)
+	assert.True(t, o.read("sonarr_tag", state).IsNull())
+}
+
+func TestOfflineSeriesResource(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, map[string]tftypes.Value{
+		"check_concurrent_changes": tftypes.NewValue(tftypes.Bool, true),
+	})
+	o.rootFolder("/config")
+
+	config := func(monitored bool) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"title":               tftypes.NewValue(tftypes.String, "Sherlock"),
+			"title_slug":          tftypes.NewValue(tftypes.String, "sherlock"),
+			"tvdb_id":             tftypes.NewValue(tftypes.Number, 176941),
+			"monitored":           tftypes.NewValue(tftypes.Bool, monitored),
+			"season_folder":       tftypes.NewValue(tftypes.Bool, true),
+			"use_scene_numbering": tftypes.NewValue(tftypes.Bool, false),
+			"path":                tftypes.NewValue(tftypes.String, "/config/sherlock"),
+			"root_folder_path":    tftypes.NewValue(tftypes.String, "/config"),
+			"quality_profile_id":  tftypes.NewValue(tftypes.Number, 1),
+		}
+	}
+
+	state, diags := o.apply("sonarr_series", tftypes.Value{}, config(false))
+	testNoErrors(t, diags)
+	assert.Equal(t, "/config", testAttribute(t, state, "root_folder_path"))
+
+	// the live series still matches the prior state
+	state, diags = o.apply("sonarr_series", state, config(true))
+	testNoErrors(t, diags)
+	assert.Equal(t, true, testAttribute(t, o.read("sonarr_series", state), "monitored"))
+
+	// changes made outside of Terraform are not overwritten
+	ctx := context.Background()
+	id, _ := testAttribute(t, state, "id").(int64)
+
+	live, _, err := o.client().SeriesAPI.GetSeriesById(ctx, int32(id)).Execute()
+	testFatal(t, err)
+
+	live.SetSeasonFolder(false)
+
+	_, _, err = o.client().SeriesAPI.UpdateSeries(ctx, strconv.FormatInt(id, 10)).SeriesResource(*live).Execute()
+	testFatal(t, err)
+
+	_, diags = o.apply("sonarr_series", state, config(false))
+	if assert.True(t, testHasError(diags)) {
+		assert.Contains(t, testErrorSummaries(diags), helpers.ConcurrentChange)
+		assert.Contains(t, testErrorSummaries(diags), "season_folder")
+	}
+
+	o.destroy("sonarr_series", state)
+}
+
+func TestOfflineSeriesResourceRootFolder(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+
+	// both are planned before the root folder is created, as in a single configuration
+	folderPlan, diags := o.plan("sonarr_root_folder", tftypes.Value{}, map[string]tftypes.Value{
+		"path": tftypes.NewValue(tftypes.String, "/shows"),
+	})
+	testNoErrors(t, diags)
+
+	seriesPlan, diags := o.plan("sonarr_series", tftypes.Value{}, map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Firefly"),
+		"title_slug":          tftypes.NewValue(tftypes.String, "firefly"),
+		"tvdb_id":             tftypes.NewValue(tftypes.Number, 78874),
+		"monitored":           tftypes.NewValue(tftypes.Bool, false),
+		"season_folder":       tftypes.NewValue(tftypes.Bool, true),
+		"use_scene_numbering": tftypes.NewValue(tftypes.Bool, false),
+		"path":                tftypes.NewValue(tftypes.String, "/shows/firefly"),
+		"root_folder_path":    tftypes.NewValue(tftypes.String, "/shows"),
+		"quality_profile_id":  tftypes.NewValue(tftypes.Number, 1),
+	})
+	testNoErrors(t, diags)
+	assert.Contains(t, testErrorSummaries(diags), helpers.InvalidReference)
+
+	folder, diags := o.applyPlan(folderPlan)
+	testNoErrors(t, diags)
+
+	series, diags := o.applyPlan(seriesPlan)
+	testNoErrors(t, diags)
+	assert.Equal(t, "/shows", testAttribute(t, series, "root_folder_path"))
+
+	o.destroy("sonarr_series", series)
+	o.destroy("sonarr_root_folder", folder)
+}
+
+func TestOfflineSeriesResourceSeasons(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+	o.rootFolder("/config")
+
+	config := map[string]tftypes.Value{
+		"title":               tftypes.NewValue(tftypes.String, "Sherlock"),
+		"title_slug":          tftypes.NewValue(tftypes.String, "sherlock"),
+		"tvdb_id":             tftypes.NewValue(tftypes.Number, 176941),
+		"monitored":           tftypes.NewValue(tftypes.Bool, true),
+		"season_folder":       tftypes.NewValue(tftypes.Bool, true),
+		"use_scene_numbering": tftypes.NewValue(tftypes.Bool, false),
+		"path":                tftypes.NewValue(tftypes.String, "/config/sherlock"),
+		"root_folder_path":    tftypes.NewValue(tftypes.String, "/config"),
+		"quality_profile_id":  tftypes.NewValue(tftypes.Number, 1),
+	}
+
+	state, diags := o.apply("sonarr_series", tftypes.Value{}, config)
+	testNoErrors(t, diags)
+	assert.Len(t, testSeasons(t, state), 4)
+
+	// a new season is aired after the series is added
+	ctx := context.Background()
+	id, _ := testAttribute(t, state, "id").(int64)
+
+	live, _, err := o.client().SeriesAPI.GetSeriesById(ctx, int32(id)).Execute()
+	testFatal(t, err)
+
+	season := sonarr.NewSeasonResource()
+	season.SetSeasonNumber(5)
+	season.SetMonitored(true)
+	live.Seasons = append(live.Seasons, *season)
+
+	_, _, err = o.client().SeriesAPI.UpdateSeries(ctx, strconv.FormatInt(id, 10)).SeriesResource(*live).Execute()
+	testFatal(t, err)
+
+	state = o.read("sonarr_series", state)
+	assert.Len(t, testSeasons(t, state), 5)
+
+	// seasons configured later are the only ones managed
+	seasonType := o.schemaType("sonarr_series", "seasons").(tftypes.Set).ElementType
+	config["seasons"] = tftypes.NewValue(o.schemaType("sonarr_series", "seasons"), []tftypes.Value{
+		tftypes.NewValue(seasonType, map[string]tftypes.Value{
+			"season_number": tftypes.NewValue(tftypes.Number, 1),
+			"monitored":     tftypes.NewValue(tftypes.Bool, false),
+		}),
+	})
+
+	state, diags = o.apply("sonarr_series", state, config)
+	testNoErrors(t, diags)
+	assert.Len(t, testSeasons(t, o.read("sonarr_series", state)), 1)
+
+	// and every season is read again once they are no longer configured
+	delete(config, "seasons")
+
+	state, diags = o.apply("sonarr_series", state, config)
+	testNoErrors(t, diags)
+	assert.Len(t, testSeasons(t, o.read("sonarr_series", state)), 5)
+
+	o.destroy("sonarr_series", state)
+}
+
+func testSeasons(t *testing.T, state tftypes.Value) []tftypes.Value {
+	t.Helper()
+
+	var seasons []tftypes.Value
+
+	raw, _ := testAttribute(t, state, "seasons").(tftypes.Value)
+	testFatal(t, raw.As(&seasons))
+
+	return seasons
+}
+
+func TestOfflineSeriesResourceAddOptions(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+	o.rootFolder("/config")
+
+	config := func(monitor string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"title":               tftypes.NewValue(tftypes.String, "Fargo"),
+			"title_slug":          tftypes.NewValue(tftypes.String, "fargo"),
+			"tvdb_id":             tftypes.NewValue(tftypes.Number, 269613),
+			"monitored":           tftypes.NewValue(tftypes.Bool, true),
+			"season_folder":       tftypes.NewValue(tftypes.Bool, true),
+			"use_scene_numbering": tftypes.NewValue(tftypes.Bool, false),
+			"path":                tftypes.NewValue(tftypes.String, "/config/fargo"),
+			"root_folder_path":    tftypes.NewValue(tftypes.String, "/config"),
+			"quality_profile_id":  tftypes.NewValue(tftypes.Number, 1),
+			"add_options": tftypes.NewValue(o.schemaType("sonarr_series", "add_options"), map[string]tftypes.Value{
+				"monitor":                          tftypes.NewValue(tftypes.String, monitor),
+				"search_for_missing_episodes":      tftypes.NewValue(tftypes.Bool, nil),
+				"search_for_cutoff_unmet_episodes": tftypes.NewValue(tftypes.Bool, nil),
+				"ignore_episodes_with_files":       tftypes.NewValue(tftypes.Bool, nil),
+				"ignore_episodes_without_files":    tftypes.NewValue(tftypes.Bool, nil),
+			}),
+		}
+	}
+
+	state, diags := o.apply("sonarr_series", tftypes.Value{}, config("none"))
+	testNoErrors(t, diags)
+
+	// a change made outside of Terraform shows that the series is not sent again
+	ctx := context.Background()
+	id, _ := testAttribute(t, state, "id").(int64)
+
+	live, _, err := o.client().SeriesAPI.GetSeriesById(ctx, int32(id)).Execute()
+	testFatal(t, err)
+
+	live.SetSeriesType(sonarr.SERIESTYPES_ANIME)
+
+	_, _, err = o.client().SeriesAPI.UpdateSeries(ctx, strconv.FormatInt(id, 10)).SeriesResource(*live).Execute()
+	testFatal(t, err)
+
+	state, diags = o.apply("sonarr_series", state, config("pilot"))
+	testNoErrors(t, diags)
+
+	live, _, err = o.client().SeriesAPI.GetSeriesById(ctx, int32(id)).Execute()
+	testFatal(t, err)
+	assert.Equal(t, sonarr.SERIESTYPES_ANIME, live.GetSeriesType())
+
+	var options map[string]tftypes.Value
+
+	raw, _ := testAttribute(t, state, "add_options").(tftypes.Value)
+	testFatal(t, raw.As(&options))
+	assert.True(t, options["monitor"].Equal(tftypes.NewValue(tftypes.String, "pilot")))
+
+	o.destroy("sonarr_series", state)
+}
+
+func TestOfflineSeriesResourceTerm(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+	o.rootFolder("/config")
+
+	_, diags := o.apply("sonarr_series", tftypes.Value{}, map[string]tftypes.Value{
+		"term":                tftypes.NewValue(tftypes.String, "imdb:tt0386676"),
+		"title":               tftypes.NewValue(tftypes.String, "The Office (US)"),
+		"monitored":           tftypes.NewValue(tftypes.Bool, false),
+		"season_folder":       tftypes.NewValue(tftypes.Bool, true),
+		"use_scene_numbering": tftypes.NewValue(tftypes.Bool, false),
+		"path":                tftypes.NewValue(tftypes.String, "/config/conflict"),
+		"root_folder_path":    tftypes.NewValue(tftypes.String, "/config"),
+		"quality_profile_id":  tftypes.NewValue(tftypes.Number, 1),
+	})
+	assert.Contains(t, testErrorSummaries(diags), "Invalid Attribute Combination")
+
+	// an exact title is still ambiguous
+	_, diags = o.apply("sonarr_series", tftypes.Value{}, map[string]tftypes.Value{
+		"term":                tftypes.NewValue(tftypes.String, "The Office"),
+		"monitored":           tftypes.NewValue(tftypes.Bool, false),
+		"season_folder":       tftypes.NewValue(tftypes.Bool, true),
+		"use_scene_numbering": tftypes.NewValue(tftypes.Bool, false),
+		"path":                tftypes.NewValue(tftypes.String, "/config/office"),
+		"root_folder_path":    tftypes.NewValue(tftypes.String, "/config"),
+		"quality_profile_id":  tftypes.NewValue(tftypes.Number, 1),
+	})
+	assert.Contains(t, testErrorSummaries(diags), "The Office (2001) tvdb_id: 78107")
+	assert.Contains(t, testErrorSummaries(diags), "The Office (US) (2005) tvdb_id: 73244")
+}
+
+func TestOfflineEpisodeResource(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+	o.rootFolder("/config")
+
+	// the episodes of the series are added later, in background
+	ctx := context.Background()
+	lookup, _, err := o.client().SeriesLookupAPI.ListSeriesLookup(ctx).Term("tvdb:78874").Execute()
+	testFatal(t, err)
+
+	request := lookup[0]
+	request.SetPath("/config/firefly")
+	request.SetQualityProfileId(1)
+
+	series, _, err := o.client().SeriesAPI.CreateSeries(ctx).SeriesResource(request).Execute()
+	testFatal(t, err)
+
+	state, diags := o.apply("sonarr_episode", tftypes.Value{}, map[string]tftypes.Value{
+		"series_id":      tftypes.NewValue(tftypes.Number, series.GetId()),
+		"season_number":  tftypes.NewValue(tftypes.Number, 1),
+		"episode_number": tftypes.NewValue(tftypes.Number, 1),
+		"monitored":      tftypes.NewValue(tftypes.Bool, false),
+	})
+	testNoErrors(t, diags)
+	assert.Equal(t, "Serenity", testAttribute(t, state, "title"))
+	assert.Equal(t, false, testAttribute(t, o.read("sonarr_episode", state), "monitored"))
+
+	o.destroy("sonarr_episode", state)
+}
+
+func TestOfflineNotificationJoinResource(t *testing.T) {
+	t.Parallel()
+
+	o := newTestOffline(t, nil)
+
+	config := map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "Offline"),
+		"api_key":      tftypes.NewValue(tftypes.String, "Key"),
+		"device_names": tftypes.NewValue(tftypes.String, "test,test1"),
+		"priority":     tftypes.NewValue(tftypes.Number, 2),
+	}
+
+	state, diags := o.apply("sonarr_notification_join", tftypes.Value{}, config)
+	testNoErrors(t, diags)
+
+	// the masked API key is kept from the state
+	state = o.read("sonarr_notification_join", state)
+	assert.Equal(t, "Key", testAttribute(t, state, "api_key"))
+	assert.Equal(t, "test,test1", testAttribute(t, state, "device_names"))
+
+	// an unset attribute is cleared
+	delete(config, "device_names")
+
+	state, diags = o.apply("sonarr_notification_join", state, config)
+	testNoErrors(t, diags)
+
+	state = o.read("sonarr_notification_join", state)
+	assert.Nil(t, testAttribute(t, state, "device_names"))
+	assert.Equal(t, "Key", testAttribute(t, state, "api_key"))
+
+	o.destroy("sonarr_notification_join", state)
+}
@@ -2,18 +2,34 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"net/url"
+	"net/http"
+	"net/http/cookiejar"
 	"os"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // needed for tf debug mode
@@ -34,7 +50,33 @@ type SonarrProvider struct {
 type Sonarr struct {
 	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
 	APIKey       types.String `tfsdk:"api_key"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
 	URL          types.String `tfsdk:"url"`
+	ConfigXML    types.String `tfsdk:"config_xml_path"`
+	Required     types.String `tfsdk:"required_version"`
+	WaitForReady types.Object `tfsdk:"wait_for_ready"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64  `tfsdk:"retry_wait_max"`
+	// TLS options
+	CACertificate      types.String `tfsdk:"ca_certificate"`
+	ClientCertificate  types.String `tfsdk:"client_certificate"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	// Limits
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	SerializeWrites       types.Bool    `tfsdk:"serialize_writes"`
+	// Updates
+	CheckConcurrentChanges types.Bool `tfsdk:"check_concurrent_changes"`
+	AdoptExisting          types.Bool `tfsdk:"adopt_existing"`
+}
+
+// WaitForReady is part of Sonarr.
+type WaitForReady struct {
+	Timeout  types.Int64 `tfsdk:"timeout"`
+	Interval types.Int64 `tfsdk:"interval"`
 }
 
 // ExtraHeader is part of Sonarr.
@@ -45,8 +87,14 @@ type ExtraHeader struct {
 
 // SonarrData defines auth and client to be used when connecting to Sonarr.
 type SonarrData struct {
-	Auth   context.Context
-	Client *sonarr.APIClient
+	Auth    context.Context
+	Client  *sonarr.APIClient
+	Cache   *helpers.ReadCache
+	Limiter *helpers.Limiter
+	// Version is the connected Sonarr version, nil if it cannot be detected.
+	Version *version.Version
+	// AdoptExisting makes create take over objects with the same natural key.
+	AdoptExisting bool
 }
 
 func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,15 +104,52 @@ func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 
 func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "The Sonarr provider is used to interact with any [Sonarr](https://sonarr.tv/) installation.\nYou must configure the provider with the proper [credentials](#api_key) before you can use it.\nUse the left navigation to read about the available resources.\n\nFor more information about Sonarr and its resources, as well as configuration guides and hints, visit the [Servarr wiki](https://wiki.servarr.com/en/sonarr).",
+		MarkdownDescription: "The Sonarr provider is used to interact with any [Sonarr](https://sonarr.tv/) installation.\nYou must configure the provider with the proper [credentials](#api_key) before you can use it.\nUse the left navigation to read about the available resources.\n\nFor more information about Sonarr and its resources, as well as configuration guides and hints, visit the [Servarr wiki](https://wiki.servarr.com/en/sonarr).\n\nTo troubleshoot API calls set `TF_LOG_PROVIDER_SONARR_API=TRACE`: requests and responses are logged with secrets masked.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "API key for Sonarr authentication. Can be specified via the `SONARR_API_KEY` environment variable.",
+				MarkdownDescription: "API key for Sonarr authentication. Can be specified via the `SONARR_API_KEY` environment variable or read from `config_xml_path`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username for Sonarr forms authentication, used with `password` to read the API key when it is not otherwise specified. Can be specified via the `SONARR_USERNAME` environment variable.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password for Sonarr forms authentication. Can be specified via the `SONARR_PASSWORD` environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Full Sonarr URL with protocol and port (e.g. `https://test.sonarr.tv:8989`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `SONARR_URL` environment variable.",
+				MarkdownDescription: "Full Sonarr URL with protocol, port and URL base if any (e.g. `https://test.sonarr.tv:8989` or `https://media.example.com/sonarr`). You should **NOT** supply the API path (`/api`), the SDK will use the appropriate paths. Can be specified via the `SONARR_URL` environment variable.",
+				Optional:            true,
+			},
+			"config_xml_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the Sonarr `config.xml` file. When set, the API key and the local URL (port, SSL and URL base) are read from it if not otherwise specified. Can be specified via the `SONARR_CONFIG_XML_PATH` environment variable.",
+				Optional:            true,
+			},
+			"wait_for_ready": schema.SingleNestedAttribute{
+				MarkdownDescription: "Wait for Sonarr to be up and to accept the API key before using it, useful when Sonarr is created in the same run.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "Maximum wait in seconds. Defaults to `300`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"interval": schema.Int64Attribute{
+						MarkdownDescription: "Wait in seconds between two checks. Defaults to `5`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"required_version": schema.StringAttribute{
+				MarkdownDescription: "Version constraint the connected Sonarr must satisfy (e.g. `>= 4.0.0`).",
 				Optional:            true,
 			},
 			"extra_headers": schema.SetNestedAttribute{
@@ -83,6 +168,70 @@ func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for idempotent requests failing with a transient error (e.g. `503` or `database is locked`). Defaults to `3`, set `0` to disable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum wait in seconds before a retry, doubled at each attempt. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle used to verify the Sonarr certificate, in addition to the system ones. Can be specified via the `SONARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Must be set together with `client_key`. Can be specified via the `SONARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client key for mutual TLS. Must be set together with `client_certificate`. Can be specified via the `SONARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Sonarr certificate. Use it only for testing. Can be specified via the `SONARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests sent to Sonarr. Defaults to `0` (unlimited).",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to Sonarr. Defaults to `0` (unlimited).",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt existing tags, custom formats, quality profiles and series with the same label, name or TVDB ID on create, instead of failing on duplicates. The adopted object is updated to match the configuration and it is deleted on destroy, as an imported one. Defaults to `false`.",
+				Optional:            true,
+			},
+			"check_concurrent_changes": schema.BoolAttribute{
+				MarkdownDescription: "Fail updates when a managed attribute was changed outside of Terraform between plan and apply, instead of overwriting the change. Defaults to `false`.",
+				Optional:            true,
+			},
+			"serialize_writes": schema.BoolAttribute{
+				MarkdownDescription: "Send write requests one at a time to avoid `database is locked` errors, reads still run in parallel. Defaults to `true`.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum wait in seconds before a retry. Defaults to `30`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -96,17 +245,68 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Defer if the connection settings depend on values not known yet
+	if unknown := unknownAttributes(data); len(unknown) > 0 {
+		tflog.Debug(ctx, "provider configuration not known yet: "+strings.Join(unknown, ", "))
+
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+
+			return
+		}
+
+		// API calls fail until the configuration is known, plans not needing them can still succeed
+		config := sonarr.NewConfiguration()
+		config.HTTPClient = &http.Client{
+			Transport: &helpers.UnknownConfigTransport{Attributes: unknown},
+		}
+
+		sonarrData := SonarrData{
+			Auth:   context.WithoutCancel(ctx),
+			Client: sonarr.NewAPIClient(config),
+		}
+		resp.DataSourceData = &sonarrData
+		resp.ResourceData = &sonarrData
+
+		return
+	}
+
+	// Read config.xml if provided
+	configXML := &helpers.ConfigXML{}
+
+	if configXMLPath := stringOrEnv(data.ConfigXML, "SONARR_CONFIG_XML_PATH"); configXMLPath != "" {
+		var err error
+
+		configXML, err = helpers.ReadConfigXML(configXMLPath)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config_xml_path"),
+				"Unable to read config.xml",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
 	// Extract URL
 	APIURL := data.URL.ValueString()
 	if APIURL == "" {
 		APIURL = os.Getenv("SONARR_URL")
 	}
 
-	parsedAPIURL, err := url.Parse(APIURL)
+	if APIURL == "" {
+		APIURL = configXML.URL()
+	}
+
+	protocol, hostpath, err := helpers.ParseURL(APIURL)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
 			"Unable to find valid URL",
-			"URL cannot parsed",
+			err.Error(),
 		)
 
 		return
@@ -119,9 +319,17 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 
 	if key == "" {
+		key = configXML.APIKey
+	}
+
+	// Fallback to login if no key is available
+	username := stringOrEnv(data.Username, "SONARR_USERNAME")
+	password := stringOrEnv(data.Password, "SONARR_PASSWORD")
+
+	if key == "" && (username == "" || password == "") {
 		resp.Diagnostics.AddError(
 			"Unable to find API key",
-			"API key cannot be an empty string",
+			"API key cannot be an empty string, set username and password to read it from Sonarr",
 		)
 
 		return
@@ -129,6 +337,44 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	// Init config
 	config := sonarr.NewConfiguration()
+	// Cache list reads for the whole run, any write clears it
+	cache := helpers.NewReadCache()
+	tlsTransport, err := newTLSTransport(data)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to configure TLS",
+			err.Error(),
+		)
+
+		return
+	}
+
+	// Opt-in API tracing, secrets are masked
+	var apiTransport http.RoundTripper = tlsTransport
+	if os.Getenv(helpers.APITraceEnv) != "" {
+		ctx = tflog.NewSubsystem(ctx, helpers.APISubsystem, tflog.WithLevelFromEnv(helpers.APITraceEnv))
+		apiTransport = &helpers.TraceTransport{Next: tlsTransport}
+	}
+
+	// Shared limiter applied to every attempt
+	limiter := helpers.NewLimiter(
+		int(data.MaxConcurrentRequests.ValueInt64()),
+		data.RequestsPerSecond.ValueFloat64(),
+		data.SerializeWrites.IsNull() || data.SerializeWrites.ValueBool(),
+	)
+	// Updates are merged with the live object to keep values not managed by the provider
+	config.HTTPClient = &http.Client{
+		Transport: &helpers.CacheTransport{
+			Next: &helpers.MergeTransport{
+				Next: newRetryTransport(
+					&helpers.LimitTransport{Next: apiTransport, Limiter: limiter},
+					data,
+					&resp.Diagnostics,
+				),
+			},
+			Cache: cache,
+		},
+	}
 	// Check extra headers
 	if len(data.ExtraHeaders.Elements()) > 0 {
 		headers := make([]ExtraHeader, len(data.ExtraHeaders.Elements()))
@@ -147,27 +393,127 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
-	// Set context for API calls
-	auth := context.WithValue(
-		context.Background(),
-		sonarr.ContextAPIKeys,
-		map[string]sonarr.APIKey{
-			"X-Api-Key": {Key: key},
-		},
-	)
-	auth = context.WithValue(auth, sonarr.ContextServerVariables, map[string]string{
-		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host,
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set context for API calls, keeping the provider logger
+	server := context.WithValue(context.WithoutCancel(ctx), sonarr.ContextServerVariables, map[string]string{
+		"protocol": protocol,
+		"hostpath": hostpath,
 	})
+	client := sonarr.NewAPIClient(config)
+
+	if key == "" {
+		// Session cookie is kept for the whole run
+		config.HTTPClient.Jar, _ = cookiejar.New(nil)
+		key = loginAPIKey(ctx, server, client, protocol+"://"+hostpath, username, password, data.WaitForReady, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	auth := context.WithValue(server, sonarr.ContextAPIKeys, map[string]sonarr.APIKey{
+		"X-Api-Key": {Key: key},
+	})
+
+	if data.CheckConcurrentChanges.ValueBool() {
+		auth = helpers.WithConcurrencyCheck(auth)
+	}
 
 	sonarrData := SonarrData{
-		Auth:   auth,
-		Client: sonarr.NewAPIClient(config),
+		Auth:          auth,
+		Client:        client,
+		Cache:         cache,
+		Limiter:       limiter,
+		AdoptExisting: data.AdoptExisting.ValueBool(),
+	}
+	if !data.WaitForReady.IsNull() {
+		waitForReady(ctx, auth, data.WaitForReady, func(ctx context.Context) error {
+			_, _, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+
+			return err
+		}, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
+
+	sonarrData.Version = detectVersion(ctx, &sonarrData, data.Required, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.DataSourceData = &sonarrData
 	resp.ResourceData = &sonarrData
 }
 
+// newTLSTransport builds the TLS options from the provider configuration with environment fallback.
+func newTLSTransport(data Sonarr) (*http.Transport, error) {
+	options := helpers.TLSOptions{
+		CACertificate:     stringOrEnv(data.CACertificate, "SONARR_CA_CERTIFICATE"),
+		ClientCertificate: stringOrEnv(data.ClientCertificate, "SONARR_CLIENT_CERTIFICATE"),
+		ClientKey:         stringOrEnv(data.ClientKey, "SONARR_CLIENT_KEY"),
+	}
+
+	if data.InsecureSkipVerify.IsNull() {
+		if env := os.Getenv("SONARR_INSECURE_SKIP_VERIFY"); env != "" {
+			insecure, err := strconv.ParseBool(env)
+			if err != nil {
+				return nil, fmt.Errorf("invalid SONARR_INSECURE_SKIP_VERIFY value: %w", err)
+			}
+
+			options.InsecureSkipVerify = insecure
+		}
+	} else {
+		options.InsecureSkipVerify = data.InsecureSkipVerify.ValueBool()
+	}
+
+	return helpers.NewTLSTransport(options)
+}
+
+func stringOrEnv(value types.String, env string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// newRetryTransport builds the retry policy from the provider configuration.
+func newRetryTransport(next http.RoundTripper, data Sonarr, diags *diag.Diagnostics) *helpers.RetryTransport {
+	transport := &helpers.RetryTransport{
+		Next:       next,
+		MaxRetries: 3,
+		WaitMin:    time.Second,
+		WaitMax:    30 * time.Second,
+	}
+
+	if !data.MaxRetries.IsNull() {
+		transport.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if !data.RetryWaitMin.IsNull() {
+		transport.WaitMin = time.Duration(data.RetryWaitMin.ValueInt64()) * time.Second
+	}
+
+	if !data.RetryWaitMax.IsNull() {
+		transport.WaitMax = time.Duration(data.RetryWaitMax.ValueInt64()) * time.Second
+	}
+
+	if transport.WaitMin > transport.WaitMax {
+		diags.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid retry configuration",
+			"retry_wait_min cannot be greater than retry_wait_max",
+		)
+	}
+
+	return transport
+}
+
 func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		// Download Clients
@@ -265,6 +611,8 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// Series
 		NewSeriesResource,
+		NewEpisodeResource,
+		NewSeriesBulkResource,
 
 		// System
 		NewHostResource,
@@ -333,6 +681,8 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewSeriesDataSource,
 		NewAllSeriessDataSource,
 		NewSearchSeriesDataSource,
+		NewEpisodeDataSource,
+		NewEpisodesDataSource,
 
 		// System
 		NewLanguageDataSource,
@@ -381,6 +731,253 @@ func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *r
 	return providerData.Auth, providerData.Client
 }
 
+// unknownAttributes lists the provider attributes needed to connect whose value is not known yet.
+func unknownAttributes(data Sonarr) []string {
+	var unknown []string
+
+	attributes := map[string]attr.Value{
+		"url":                  data.URL,
+		"api_key":              data.APIKey,
+		"username":             data.Username,
+		"password":             data.Password,
+		"config_xml_path":      data.ConfigXML,
+		"extra_headers":        data.ExtraHeaders,
+		"ca_certificate":       data.CACertificate,
+		"client_certificate":   data.ClientCertificate,
+		"client_key":           data.ClientKey,
+		"insecure_skip_verify": data.InsecureSkipVerify,
+		"required_version":     data.Required,
+		"wait_for_ready":       data.WaitForReady,
+	}
+
+	for name, value := range attributes {
+		if value.IsUnknown() {
+			unknown = append(unknown, name)
+		}
+	}
+
+	slices.Sort(unknown)
+
+	return unknown
+}
+
+// loginAPIKey logs in with username and password and reads the API key from the host configuration.
+func loginAPIKey(ctx, server context.Context, client *sonarr.APIClient, baseURL, username, password string, wait types.Object, diags *diag.Diagnostics) string {
+	var key string
+
+	login := func(ctx context.Context) error {
+		if err := helpers.Login(ctx, client.GetConfig().HTTPClient, baseURL, username, password); err != nil {
+			return err
+		}
+
+		host, _, err := client.HostConfigAPI.GetHostConfig(ctx).Execute()
+		if err != nil {
+			return err
+		}
+
+		key = host.GetApiKey()
+
+		return nil
+	}
+
+	if !wait.IsNull() {
+		waitForReady(ctx, server, wait, login, diags)
+
+		return key
+	}
+
+	if err := login(server); err != nil {
+		diags.AddAttributeError(path.Root("username"), "Unable to login", helpers.ParseClientError("login to", "Sonarr", err))
+	}
+
+	return key
+}
+
+// waitForReady runs check with the API context until it succeeds or the timeout expires.
+func waitForReady(ctx, api context.Context, config types.Object, check func(context.Context) error, diags *diag.Diagnostics) {
+	var wait WaitForReady
+
+	diags.Append(config.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+
+	if diags.HasError() {
+		return
+	}
+
+	timeout, interval := 300*time.Second, 5*time.Second
+	if !wait.Timeout.IsNull() {
+		timeout = time.Duration(wait.Timeout.ValueInt64()) * time.Second
+	}
+
+	if !wait.Interval.IsNull() {
+		interval = time.Duration(wait.Interval.ValueInt64()) * time.Second
+	}
+
+	pollReady(ctx, api, timeout, interval, check, diags)
+}
+
+// pollReady calls check every interval until it succeeds, the timeout expires or ctx is canceled.
+// Retries are disabled, so that each poll is a single request.
+func pollReady(ctx, api context.Context, timeout, interval time.Duration, check func(context.Context) error, diags *diag.Diagnostics) {
+	deadline, cancel := context.WithTimeout(helpers.WithoutRetry(api), timeout)
+	defer cancel()
+
+	// The API context outlives the configure request, stop polling if it is canceled
+	stop := context.AfterFunc(ctx, cancel)
+	defer stop()
+
+	for {
+		err := check(deadline)
+		if err == nil {
+			return
+		}
+
+		// Wrong credentials will not fix themselves
+		if errors.Is(err, helpers.ErrLoginFailed) {
+			diags.AddAttributeError(path.Root("username"), "Unable to login", err.Error())
+
+			return
+		}
+
+		tflog.Debug(ctx, "waiting for Sonarr to be ready: "+err.Error())
+
+		select {
+		case <-deadline.Done():
+			if ctx.Err() != nil {
+				diags.AddAttributeError(path.Root("wait_for_ready"), "Sonarr not ready", "Waiting for Sonarr was canceled, last error: "+err.Error())
+
+				return
+			}
+
+			diags.AddAttributeError(
+				path.Root("wait_for_ready"),
+				"Sonarr not ready",
+				fmt.Sprintf("Sonarr did not become ready within %s, last error: %s", timeout, err),
+			)
+
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// detectVersion queries Sonarr once and checks the required version if any.
+func detectVersion(ctx context.Context, data *SonarrData, required types.String, diags *diag.Diagnostics) *version.Version {
+	status, _, err := data.Client.SystemAPI.GetSystemStatus(data.Auth).Execute()
+	if err != nil {
+		if !required.IsNull() {
+			diags.AddAttributeError(path.Root("required_version"), helpers.ClientError, helpers.ParseClientError(helpers.Read, "system status", err))
+		}
+
+		// Version gating is skipped, each request will report its own error
+		tflog.Warn(ctx, "unable to detect Sonarr version: "+err.Error())
+
+		return nil
+	}
+
+	current, err := version.NewVersion(status.GetVersion())
+	if err != nil {
+		tflog.Warn(ctx, "unable to parse Sonarr version: "+err.Error())
+
+		return nil
+	}
+
+	tflog.Debug(ctx, "connected to Sonarr "+current.String())
+
+	if !required.IsNull() {
+		if err := helpers.CheckRequiredVersion(current, required.ValueString()); err != nil {
+			diags.AddAttributeError(path.Root("required_version"), helpers.UnsupportedVersion, err.Error())
+		}
+	}
+
+	return current
+}
+
+// resourceVersion returns the connected Sonarr version, nil if unknown.
+func resourceVersion(req resource.ConfigureRequest) *version.Version {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.Version
+	}
+
+	return nil
+}
+
+// resourceAdopt returns true if existing objects are adopted on create.
+func resourceAdopt(req resource.ConfigureRequest) bool {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.AdoptExisting
+	}
+
+	return false
+}
+
+// updateContext returns the context of an update request.
+// The attributes unset in the plan are marked to be cleared from the live object.
+// When concurrency checks are enabled, the payload built from the prior state is attached
+// so that the update fails if the live object was changed outside of Terraform.
+func updateContext[T any](ctx, auth context.Context, req resource.UpdateRequest, diags *diag.Diagnostics, build func(*T) any) context.Context {
+	auth = helpers.WithClearedAttributes(auth, clearedAttributes(req.Plan))
+
+	if !helpers.ConcurrencyCheckEnabled(auth) {
+		return auth
+	}
+
+	var prior *T
+
+	diags.Append(req.State.Get(ctx, &prior)...)
+
+	if diags.HasError() || prior == nil {
+		return auth
+	}
+
+	return helpers.WithPriorState(auth, build(prior))
+}
+
+// clearedAttributes returns the attributes that are null or empty in the plan.
+func clearedAttributes(plan tfsdk.Plan) []string {
+	var attributes map[string]tftypes.Value
+	if err := plan.Raw.As(&attributes); err != nil {
+		return nil
+	}
+
+	cleared := []string{}
+
+	for name, value := range attributes {
+		if !value.IsKnown() {
+			continue
+		}
+
+		if value.IsNull() || isEmptyCollection(value) {
+			cleared = append(cleared, name)
+		}
+	}
+
+	return cleared
+}
+
+func isEmptyCollection(value tftypes.Value) bool {
+	switch value.Type().(type) {
+	case tftypes.List, tftypes.Set:
+		var elements []tftypes.Value
+
+		return value.As(&elements) == nil && len(elements) == 0
+	case tftypes.Map:
+		var elements map[string]tftypes.Value
+
+		return value.As(&elements) == nil && len(elements) == 0
+	default:
+		return false
+	}
+}
+
+// resourceCache returns the provider read cache, nil if the provider is not configured.
+func resourceCache(req resource.ConfigureRequest) *helpers.ReadCache {
+	if providerData, ok := req.ProviderData.(*SonarrData); ok {
+		return providerData.Cache
+	}
+
+	return nil
+}
+
 func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
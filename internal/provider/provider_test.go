@@ -1,12 +1,27 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/testserver"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -17,6 +32,23 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"sonarr": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestMain runs the acceptance tests against an in-memory Sonarr unless SONARR_URL points to a real instance.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") != "" && os.Getenv("SONARR_URL") == "" {
+		server := testserver.New()
+		os.Setenv("SONARR_URL", server.URL)
+		os.Setenv("SONARR_API_KEY", testserver.APIKey)
+
+		testUnauthorizedProvider = unauthorizedProvider(server.URL)
+		code := m.Run()
+
+		server.Close()
+		os.Exit(code)
+	}
+
+	os.Exit(m.Run())
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
@@ -37,9 +69,12 @@ func testAccAPIClient() *sonarr.APIClient {
 	return sonarr.NewAPIClient(config)
 }
 
-const testUnauthorizedProvider = `
+var testUnauthorizedProvider = unauthorizedProvider("http://localhost:8989")
+
+func unauthorizedProvider(url string) string {
+	return fmt.Sprintf(`
 provider "sonarr" {
-	url = "http://localhost:8989"
+	url = "%s"
 	api_key = "ErrorAPIKey"
 	extra_headers = [
 		{
@@ -48,4 +83,192 @@ provider "sonarr" {
 		}
 	]
   }
-`
+`, url)
+}
+
+func TestUpdateContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := resource.SchemaResponse{}
+	(&TagResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":    tftypes.NewValue(tftypes.Number, 1),
+			"label": tftypes.NewValue(tftypes.String, "prior"),
+		}),
+	}
+
+	tests := map[string]struct {
+		auth  context.Context
+		state tfsdk.State
+		built bool
+		err   bool
+	}{
+		"disabled": {
+			auth:  ctx,
+			state: state,
+		},
+		"enabled": {
+			auth:  helpers.WithConcurrencyCheck(ctx),
+			state: state,
+			built: true,
+		},
+		"invalid_state": {
+			auth:  helpers.WithConcurrencyCheck(ctx),
+			state: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(tftypes.String, "invalid")},
+			err:   true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			built := false
+			updateContext(ctx, test.auth, resource.UpdateRequest{State: test.state}, &diags, func(prior *Tag) any {
+				built = true
+
+				assert.Equal(t, "prior", prior.Label.ValueString())
+
+				return prior
+			})
+
+			assert.Equal(t, test.built, built)
+			assert.Equal(t, test.err, diags.HasError())
+		})
+	}
+}
+
+func TestClearedAttributes(t *testing.T) {
+	t.Parallel()
+
+	plan := tfsdk.Plan{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id":           schema.Int64Attribute{Computed: true},
+				"name":         schema.StringAttribute{Required: true},
+				"api_key":      schema.StringAttribute{Optional: true},
+				"device_names": schema.StringAttribute{Optional: true},
+				"tags":         schema.SetAttribute{Optional: true, ElementType: types.Int64Type},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"id":           tftypes.Number,
+			"name":         tftypes.String,
+			"api_key":      tftypes.String,
+			"device_names": tftypes.String,
+			"tags":         tftypes.Set{ElementType: tftypes.Number},
+		}}, map[string]tftypes.Value{
+			"id":           tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			"name":         tftypes.NewValue(tftypes.String, "test"),
+			"api_key":      tftypes.NewValue(tftypes.String, "key"),
+			"device_names": tftypes.NewValue(tftypes.String, nil),
+			"tags":         tftypes.NewValue(tftypes.Set{ElementType: tftypes.Number}, []tftypes.Value{}),
+		}),
+	}
+
+	assert.ElementsMatch(t, []string{"device_names", "tags"}, clearedAttributes(plan))
+}
+
+func TestPollReady(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		failures int
+		timeout  time.Duration
+		cancel   time.Duration
+		requests int
+		err      string
+	}{
+		"ready": {
+			failures: 0,
+			timeout:  time.Second,
+			requests: 1,
+		},
+		"eventually_ready": {
+			failures: 2,
+			timeout:  5 * time.Second,
+			requests: 3,
+		},
+		"timeout": {
+			failures: 1000,
+			timeout:  200 * time.Millisecond,
+			err:      "did not become ready within 200ms",
+		},
+		"canceled": {
+			failures: 1000,
+			timeout:  time.Minute,
+			cancel:   200 * time.Millisecond,
+			err:      "canceled",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if int(requests.Add(1)) <= test.failures {
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"version":"4.0.0.0"}`))
+			}))
+			t.Cleanup(server.Close)
+
+			config := sonarr.NewConfiguration()
+			config.Servers[0].URL = server.URL
+			// Retries would wait at least one second between attempts
+			config.HTTPClient = &http.Client{Transport: &helpers.RetryTransport{
+				Next:       http.DefaultTransport,
+				MaxRetries: 3,
+				WaitMin:    time.Second,
+				WaitMax:    time.Second,
+			}}
+			client := sonarr.NewAPIClient(config)
+
+			ctx := context.Background()
+
+			if test.cancel > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, test.cancel)
+				t.Cleanup(cancel)
+			}
+
+			var diags diag.Diagnostics
+
+			start := time.Now()
+			pollReady(ctx, context.WithoutCancel(ctx), test.timeout, 10*time.Millisecond, func(ctx context.Context) error {
+				_, _, err := client.SystemAPI.GetSystemStatus(ctx).Execute()
+
+				return err
+			}, &diags)
+
+			assert.Less(t, time.Since(start), time.Second)
+
+			if test.err == "" {
+				assert.False(t, diags.HasError())
+				assert.Equal(t, test.requests, int(requests.Load()))
+
+				return
+			}
+
+			if assert.True(t, diags.HasError()) {
+				assert.Contains(t, diags.Errors()[0].Detail(), test.err)
+			}
+		})
+	}
+}
@@ -143,7 +143,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Read to get the quality ID
 	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, request.GetId()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, qualityDefinitionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -154,7 +154,7 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	// Create new QualityDefinition
 	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, qualityDefinitionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -178,7 +178,7 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 	// Get qualitydefinition current value
 	response, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, int32(definition.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionResourceName, err))
+		helpers.HandleReadError(ctx, qualityDefinitionResourceName, err, resp)
 
 		return
 	}
@@ -202,10 +202,18 @@ func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.Upd
 	// Build Update resource
 	request := definition.read()
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *QualityDefinition) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityDefinitionResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, qualityDefinitionResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -26,6 +28,7 @@ const qualityProfileResourceName = "quality_profile"
 var (
 	_ resource.Resource                = &QualityProfileResource{}
 	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &QualityProfileResource{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -34,8 +37,11 @@ func NewQualityProfileResource() resource.Resource {
 
 // QualityProfileResource defines the quality profile implementation.
 type QualityProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	cache   *helpers.ReadCache
+	version *version.Version
+	adopt   bool
 }
 
 // QualityProfile describes the quality profile data model.
@@ -252,9 +258,26 @@ func (r *QualityProfileResource) Configure(ctx context.Context, req resource.Con
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.version = resourceVersion(req)
+		r.adopt = resourceAdopt(req)
 	}
 }
 
+func (r *QualityProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var items types.Set
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("format_items"), &items)...)
+	// Check that referenced objects exist
+	newReferences(r.client, r.auth, r.cache).validateCustomFormats(ctx, items, &resp.Diagnostics)
+	// Check that the configured attributes are supported
+	helpers.CheckAttributeVersion(ctx, req.Config, path.Root("min_upgrade_format_score"), r.version, helpers.MinUpgradeFormatScoreVersion, &resp.Diagnostics)
+}
+
 func (r *QualityProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var profile *QualityProfile
@@ -268,10 +291,27 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 	// Build Create resource
 	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
 
-	// Create new QualityProfile
-	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
+	// Adopt the existing quality profile with the same name
+	existing, err := r.findAdoptable(request.GetName())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
+
+		return
+	}
+
+	var response *sonarr.QualityProfileResource
+
+	if existing != nil {
+		tflog.Info(ctx, "adopting existing "+qualityProfileResourceName+": "+strconv.Itoa(int(existing.GetId())))
+		request.SetId(existing.GetId())
+		response, _, err = r.client.QualityProfileAPI.UpdateQualityProfile(helpers.WithClearedAttributes(r.auth, clearedAttributes(req.Plan)), strconv.Itoa(int(existing.GetId()))).QualityProfileResource(*request).Execute()
+	} else {
+		// Create new QualityProfile
+		response, _, err = r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityProfileResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, qualityProfileResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -293,9 +333,9 @@ func (r *QualityProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get qualityprofile current value
-	response, _, err := r.client.QualityProfileAPI.GetQualityProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, err := helpers.CachedGet(r.cache, qualityProfileResourceName, int32(profile.ID.ValueInt64()), r.listQualityProfile, getQualityProfileID)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileResourceName, err))
+		helpers.HandleReadError(ctx, qualityProfileResourceName, err, resp)
 
 		return
 	}
@@ -317,12 +357,22 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Build Update resource
-	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
+	qualities := r.getQualityIDs(&resp.Diagnostics)
+	formats := r.getFormatsIDs(&resp.Diagnostics)
+	request := profile.read(ctx, qualities, formats, &resp.Diagnostics)
+
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *QualityProfile) any {
+		return prior.read(ctx, qualities, formats, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Update QualityProfile
-	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(r.auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
+	response, _, err := r.client.QualityProfileAPI.UpdateQualityProfile(auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityProfileResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, qualityProfileResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -355,7 +405,13 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, qualityProfileResourceName, helpers.NameImportKey,
+		r.listQualityProfile,
+		func(item *sonarr.QualityProfileResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value)
+		},
+		getQualityProfileID,
+	)
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
@@ -598,3 +654,24 @@ func (r QualityProfileResource) getFormatsIDs(diags *diag.Diagnostics) []int32 {
 
 	return formatIDs
 }
+
+func (r *QualityProfileResource) listQualityProfile() ([]sonarr.QualityProfileResource, error) {
+	response, _, err := r.client.QualityProfileAPI.ListQualityProfile(r.auth).Execute()
+
+	return response, err
+}
+
+func getQualityProfileID(item *sonarr.QualityProfileResource) int32 {
+	return item.GetId()
+}
+
+// findAdoptable returns the quality profile with the same name, if adoption is enabled.
+func (r *QualityProfileResource) findAdoptable(name string) (*sonarr.QualityProfileResource, error) {
+	if !r.adopt {
+		return nil, nil
+	}
+
+	return helpers.CachedFind(r.cache, qualityProfileResourceName, r.listQualityProfile, getQualityProfileID, func(profile *sonarr.QualityProfileResource) bool {
+		return strings.EqualFold(profile.GetName(), name)
+	})
+}
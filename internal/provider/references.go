@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// references validates at plan time that the objects referenced by a resource exist.
+// Unknown values are skipped since they will be known only at apply time.
+type references struct {
+	client *sonarr.APIClient
+	auth   context.Context
+	cache  *helpers.ReadCache
+}
+
+func newReferences(client *sonarr.APIClient, auth context.Context, cache *helpers.ReadCache) *references {
+	// Nothing to validate if the provider is not configured yet
+	if client == nil {
+		return nil
+	}
+
+	return &references{
+		client: client,
+		auth:   auth,
+		cache:  cache,
+	}
+}
+
+// validateTags checks the tags attribute of a plan.
+func (r *references) validateTags(ctx context.Context, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	var (
+		set  types.Set
+		tags []types.Int64
+	)
+
+	if r == nil || plan.Raw.IsNull() {
+		return
+	}
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("tags"), &set)...)
+
+	if set.IsNull() || set.IsUnknown() {
+		return
+	}
+
+	diags.Append(set.ElementsAs(ctx, &tags, false)...)
+
+	for _, tag := range tags {
+		if tag.IsNull() || tag.IsUnknown() {
+			continue
+		}
+
+		_, err := helpers.CachedGet(r.cache, tagResourceName, int32(tag.ValueInt64()), func() ([]sonarr.TagResource, error) {
+			response, _, err := r.client.TagAPI.ListTag(r.auth).Execute()
+
+			return response, err
+		}, func(t *sonarr.TagResource) int32 { return t.GetId() })
+		r.report(path.Root("tags"), tagResourceName, "id", strconv.FormatInt(tag.ValueInt64(), 10), err, diags)
+	}
+}
+
+// validateQualityProfile checks a quality profile ID attribute.
+func (r *references) validateQualityProfile(p path.Path, profile types.Int64, diags *diag.Diagnostics) {
+	if r == nil || profile.IsNull() || profile.IsUnknown() {
+		return
+	}
+
+	_, err := helpers.CachedGet(r.cache, qualityProfileResourceName, int32(profile.ValueInt64()), func() ([]sonarr.QualityProfileResource, error) {
+		response, _, err := r.client.QualityProfileAPI.ListQualityProfile(r.auth).Execute()
+
+		return response, err
+	}, getQualityProfileID)
+	r.report(p, qualityProfileResourceName, "id", strconv.FormatInt(profile.ValueInt64(), 10), err, diags)
+}
+
+// validateRootFolder checks a root folder path attribute.
+// A missing folder is only a warning, since it can be created in the same configuration.
+func (r *references) validateRootFolder(p path.Path, folder types.String, diags *diag.Diagnostics) {
+	if r == nil || folder.IsNull() || folder.IsUnknown() {
+		return
+	}
+
+	folders, err := helpers.CachedList(r.cache, rootFolderResourceName, func() ([]sonarr.RootFolderResource, error) {
+		response, _, err := r.client.RootFolderAPI.ListRootFolder(r.auth).Execute()
+
+		return response, err
+	}, func(f *sonarr.RootFolderResource) int32 { return f.GetId() })
+
+	if err != nil {
+		r.report(p, rootFolderResourceName, "path", folder.ValueString(), err, diags)
+
+		return
+	}
+
+	for _, f := range folders {
+		if strings.TrimSuffix(f.GetPath(), "/") == strings.TrimSuffix(folder.ValueString(), "/") {
+			return
+		}
+	}
+
+	diags.AddAttributeWarning(p, helpers.InvalidReference, helpers.ParseReferenceError(rootFolderResourceName, "path", folder.ValueString()))
+}
+
+// validateCustomFormats checks the format IDs of the format_items attribute.
+func (r *references) validateCustomFormats(ctx context.Context, items types.Set, diags *diag.Diagnostics) {
+	if r == nil || items.IsNull() || items.IsUnknown() {
+		return
+	}
+
+	formats := make([]FormatItem, len(items.Elements()))
+	diags.Append(items.ElementsAs(ctx, &formats, false)...)
+
+	for _, f := range formats {
+		if f.Format.IsNull() || f.Format.IsUnknown() {
+			continue
+		}
+
+		_, err := helpers.CachedGet(r.cache, customFormatResourceName, int32(f.Format.ValueInt64()), func() ([]sonarr.CustomFormatResource, error) {
+			response, _, err := r.client.CustomFormatAPI.ListCustomFormat(r.auth).Execute()
+
+			return response, err
+		}, getCustomFormatID)
+		r.report(path.Root("format_items"), customFormatResourceName, "id", strconv.FormatInt(f.Format.ValueInt64(), 10), err, diags)
+	}
+}
+
+func (r *references) report(p path.Path, kind, field, value string, err error, diags *diag.Diagnostics) {
+	switch {
+	case err == nil, errors.Is(err, helpers.ErrUnknownConfiguration):
+		return
+	case helpers.IsNotFoundError(err):
+		diags.AddAttributeError(p, helpers.InvalidReference, helpers.ParseReferenceError(kind, field, value))
+	default:
+		diags.AddAttributeError(p, helpers.ClientError, helpers.ParseClientError(helpers.Read, kind, err))
+	}
+}
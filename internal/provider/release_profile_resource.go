@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -139,7 +140,7 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 	// Create new ReleaseProfile
 	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(r.auth).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, releaseProfileResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, releaseProfileResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -163,7 +164,7 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get releaseprofile current value
 	response, _, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileResourceName, err))
+		helpers.HandleReadError(ctx, releaseProfileResourceName, err, resp)
 
 		return
 	}
@@ -187,10 +188,18 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 	// Build Update resource
 	request := profile.read(ctx, &resp.Diagnostics)
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ReleaseProfile) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Update ReleaseProfile
-	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(r.auth, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
+	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(auth, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, releaseProfileResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, releaseProfileResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -223,7 +232,19 @@ func (r *ReleaseProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ReleaseProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, releaseProfileResourceName, helpers.NameImportKey,
+		func() ([]sonarr.ReleaseProfileResource, error) {
+			response, _, err := r.client.ReleaseProfileAPI.ListReleaseProfile(r.auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.ReleaseProfileResource, value string) bool {
+			return strings.EqualFold(item.GetName(), value)
+		},
+		func(item *sonarr.ReleaseProfileResource) int32 {
+			return item.GetId()
+		},
+	)
 	tflog.Trace(ctx, "imported "+releaseProfileResourceName+": "+req.ID)
 }
 
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -105,7 +106,7 @@ func (r *RemotePathMappingResource) Create(ctx context.Context, req resource.Cre
 
 	response, _, err := r.client.RemotePathMappingAPI.CreateRemotePathMapping(r.auth).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, remotePathMappingResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, remotePathMappingResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -129,7 +130,7 @@ func (r *RemotePathMappingResource) Read(ctx context.Context, req resource.ReadR
 	// Get remotePathMapping current value
 	response, _, err := r.client.RemotePathMappingAPI.GetRemotePathMappingById(r.auth, int32(mapping.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, remotePathMappingResourceName, err))
+		helpers.HandleReadError(ctx, remotePathMappingResourceName, err, resp)
 
 		return
 	}
@@ -153,9 +154,17 @@ func (r *RemotePathMappingResource) Update(ctx context.Context, req resource.Upd
 	// Update RemotePathMapping
 	request := mapping.read()
 
-	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(r.auth, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *RemotePathMapping) any {
+		return prior.read()
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.RemotePathMappingAPI.UpdateRemotePathMapping(auth, strconv.Itoa(int(request.GetId()))).RemotePathMappingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, remotePathMappingResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, remotePathMappingResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -188,7 +197,22 @@ func (r *RemotePathMappingResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *RemotePathMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, remotePathMappingResourceName, helpers.PathImportKey,
+		func() ([]sonarr.RemotePathMappingResource, error) {
+			response, _, err := r.client.RemotePathMappingAPI.ListRemotePathMapping(r.auth).Execute()
+
+			return response, err
+		},
+		// either the remote or the local path
+		func(item *sonarr.RemotePathMappingResource, value string) bool {
+			value = strings.TrimSuffix(value, "/")
+
+			return strings.TrimSuffix(item.GetRemotePath(), "/") == value || strings.TrimSuffix(item.GetLocalPath(), "/") == value
+		},
+		func(item *sonarr.RemotePathMappingResource) int32 {
+			return item.GetId()
+		},
+	)
 	tflog.Trace(ctx, "imported "+remotePathMappingResourceName+": "+req.ID)
 }
 
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -144,7 +145,7 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 
 	response, _, err := r.client.RootFolderAPI.CreateRootFolder(r.auth).RootFolderResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, rootFolderResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -168,7 +169,7 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get rootFolder current value
 	response, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
+		helpers.HandleReadError(ctx, rootFolderResourceName, err, resp)
 
 		return
 	}
@@ -205,7 +206,19 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *RootFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, rootFolderResourceName, helpers.PathImportKey,
+		func() ([]sonarr.RootFolderResource, error) {
+			response, _, err := r.client.RootFolderAPI.ListRootFolder(r.auth).Execute()
+
+			return response, err
+		},
+		func(item *sonarr.RootFolderResource, value string) bool {
+			return strings.TrimSuffix(item.GetPath(), "/") == strings.TrimSuffix(value, "/")
+		},
+		func(item *sonarr.RootFolderResource) int32 {
+			return item.GetId()
+		},
+	)
 	tflog.Trace(ctx, "imported "+rootFolderResourceName+": "+req.ID)
 }
 
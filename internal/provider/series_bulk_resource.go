@@ -0,0 +1,333 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const seriesBulkResourceName = "series_bulk"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SeriesBulkResource{}
+
+func NewSeriesBulkResource() resource.Resource {
+	return &SeriesBulkResource{}
+}
+
+// SeriesBulkResource defines the series bulk implementation.
+type SeriesBulkResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+	cache  *helpers.ReadCache
+}
+
+// SeriesBulk describes the series bulk data model.
+type SeriesBulk struct {
+	SeriesIDs        types.Set    `tfsdk:"series_ids"`
+	Tags             types.Set    `tfsdk:"tags"`
+	ID               types.String `tfsdk:"id"`
+	RootFolderPath   types.String `tfsdk:"root_folder_path"`
+	SeriesType       types.String `tfsdk:"series_type"`
+	MonitorNewItems  types.String `tfsdk:"monitor_new_items"`
+	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+	SeasonFolder     types.Bool   `tfsdk:"season_folder"`
+	MoveFiles        types.Bool   `tfsdk:"move_files"`
+}
+
+func (r *SeriesBulkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + seriesBulkResourceName
+}
+
+func (r *SeriesBulkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nSeries bulk resource.\nIt applies the same settings to a set of existing series through the series editor. Only the configured attributes are managed.\nFor more information refer to [Series](https://wiki.servarr.com/sonarr/library#series) documentation.",
+		Attributes: map[string]schema.Attribute{
+			"series_ids": schema.SetAttribute{
+				MarkdownDescription: "List of series IDs. Changing it replaces the resource, as its ID is derived from the list.",
+				Required:            true,
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality Profile ID.",
+				Optional:            true,
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Series Root Folder.",
+				Optional:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Optional:            true,
+			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new seasons. Valid values are: `all`, `none`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sonarr.NEWITEMMONITORTYPES_ALL),
+						string(sonarr.NEWITEMMONITORTYPES_NONE),
+					),
+				},
+			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type. Valid values are: `standard`, `daily`, `anime`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sonarr.SERIESTYPES_STANDARD),
+						string(sonarr.SERIESTYPES_DAILY),
+						string(sonarr.SERIESTYPES_ANIME),
+					),
+				},
+			},
+			"season_folder": schema.BoolAttribute{
+				MarkdownDescription: "Season Folder flag.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags. They replace the existing ones.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"move_files": schema.BoolAttribute{
+				MarkdownDescription: "Move files when the root folder changes.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Series bulk ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SeriesBulkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+		r.cache = resourceCache(req)
+	}
+}
+
+func (r *SeriesBulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var bulk *SeriesBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the settings to all series
+	request := bulk.read(ctx, &resp.Diagnostics)
+
+	hash, err := hashstructure.Hash(request.GetSeriesIds(), hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, helpers.ParseClientError(helpers.Create, seriesBulkResourceName, err))
+
+		return
+	}
+
+	if _, err := r.client.SeriesEditorAPI.PutSeriesEditor(r.auth).SeriesEditorResource(*request).Execute(); err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, seriesBulkResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
+	bulk.ID = types.StringValue(strconv.FormatUint(hash, 10))
+	tflog.Trace(ctx, "created "+seriesBulkResourceName+": "+bulk.ID.ValueString())
+	// Generate resource state struct
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *SeriesBulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var bulk *SeriesBulk
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get all series current value with a single call
+	response, err := helpers.CachedList(r.cache, seriesResourceName, func() ([]sonarr.SeriesResource, error) {
+		series, _, err := r.client.SeriesAPI.ListSeries(r.auth).Execute()
+
+		return series, err
+	}, getSeriesID)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesBulkResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+seriesBulkResourceName+": "+bulk.ID.ValueString())
+	// Map response body to resource schema attribute
+	bulk.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *SeriesBulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var bulk *SeriesBulk
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &bulk)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Apply the settings to all series
+	request := bulk.read(ctx, &resp.Diagnostics)
+
+	if _, err := r.client.SeriesEditorAPI.PutSeriesEditor(r.auth).SeriesEditorResource(*request).Execute(); err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, seriesBulkResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+seriesBulkResourceName+": "+bulk.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &bulk)...)
+}
+
+func (r *SeriesBulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID string
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Bulk settings cannot be reverted just removing configuration
+	tflog.Trace(ctx, "decoupled "+seriesBulkResourceName+": "+ID)
+	resp.State.RemoveResource(ctx)
+}
+
+// write maps the managed series, any series diverging from the state is reported as drift.
+func (b *SeriesBulk) write(ctx context.Context, series []sonarr.SeriesResource, diags *diag.Diagnostics) {
+	var (
+		managed  []int32
+		found    []int32
+		tempDiag diag.Diagnostics
+	)
+
+	want := *b
+
+	diags.Append(want.SeriesIDs.ElementsAs(ctx, &managed, false)...)
+
+	for _, s := range series {
+		if !slices.Contains(managed, s.GetId()) {
+			continue
+		}
+
+		found = append(found, s.GetId())
+
+		if !want.QualityProfileID.IsNull() && want.QualityProfileID.ValueInt64() != int64(s.GetQualityProfileId()) {
+			b.QualityProfileID = types.Int64Value(int64(s.GetQualityProfileId()))
+		}
+
+		if !want.RootFolderPath.IsNull() && want.RootFolderPath.ValueString() != s.GetRootFolderPath() {
+			b.RootFolderPath = types.StringValue(s.GetRootFolderPath())
+		}
+
+		if !want.SeriesType.IsNull() && want.SeriesType.ValueString() != string(s.GetSeriesType()) {
+			b.SeriesType = types.StringValue(string(s.GetSeriesType()))
+		}
+
+		if !want.MonitorNewItems.IsNull() && want.MonitorNewItems.ValueString() != string(s.GetMonitorNewItems()) {
+			b.MonitorNewItems = types.StringValue(string(s.GetMonitorNewItems()))
+		}
+
+		if !want.Monitored.IsNull() && want.Monitored.ValueBool() != s.GetMonitored() {
+			b.Monitored = types.BoolValue(s.GetMonitored())
+		}
+
+		if !want.SeasonFolder.IsNull() && want.SeasonFolder.ValueBool() != s.GetSeasonFolder() {
+			b.SeasonFolder = types.BoolValue(s.GetSeasonFolder())
+		}
+
+		if !want.Tags.IsNull() {
+			tags, tempDiag := types.SetValueFrom(ctx, types.Int64Type, s.GetTags())
+			diags.Append(tempDiag...)
+
+			if !want.Tags.Equal(tags) {
+				b.Tags = tags
+			}
+		}
+	}
+
+	// Series deleted outside of terraform are removed from the managed ones
+	b.SeriesIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, found)
+	diags.Append(tempDiag...)
+}
+
+func (b *SeriesBulk) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.SeriesEditorResource {
+	editor := sonarr.NewSeriesEditorResource()
+	diags.Append(b.SeriesIDs.ElementsAs(ctx, &editor.SeriesIds, false)...)
+	slices.Sort(editor.SeriesIds)
+	editor.SetMoveFiles(b.MoveFiles.ValueBool())
+
+	if !b.QualityProfileID.IsNull() {
+		editor.SetQualityProfileId(int32(b.QualityProfileID.ValueInt64()))
+	}
+
+	if !b.RootFolderPath.IsNull() {
+		editor.SetRootFolderPath(b.RootFolderPath.ValueString())
+	}
+
+	if !b.SeriesType.IsNull() {
+		editor.SetSeriesType(sonarr.SeriesTypes(b.SeriesType.ValueString()))
+	}
+
+	if !b.MonitorNewItems.IsNull() {
+		editor.SetMonitorNewItems(sonarr.NewItemMonitorTypes(b.MonitorNewItems.ValueString()))
+	}
+
+	if !b.Monitored.IsNull() {
+		editor.SetMonitored(b.Monitored.ValueBool())
+	}
+
+	if !b.SeasonFolder.IsNull() {
+		editor.SetSeasonFolder(b.SeasonFolder.ValueBool())
+	}
+
+	if !b.Tags.IsNull() {
+		editor.SetApplyTags(sonarr.APPLYTAGS_REPLACE)
+		diags.Append(b.Tags.ElementsAs(ctx, &editor.Tags, false)...)
+	}
+
+	return editor
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccSeriesBulkResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccSeriesBulkResourceConfig("false", "sonarr_series.bulk_one.id, sonarr_series.bulk_two.id") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesBulkResourceConfig("false", "sonarr_series.bulk_one.id, sonarr_series.bulk_two.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series_bulk.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("sonarr_series_bulk.test", "series_type", "anime"),
+					resource.TestCheckResourceAttr("sonarr_series_bulk.test", "series_ids.#", "2"),
+					resource.TestCheckResourceAttrSet("sonarr_series_bulk.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccSeriesBulkResourceConfig("false", "sonarr_series.bulk_one.id, sonarr_series.bulk_two.id") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSeriesBulkResourceConfig("true", "sonarr_series.bulk_one.id, sonarr_series.bulk_two.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series_bulk.test", "monitored", "true"),
+				),
+			},
+			// Changed series replace the resource
+			{
+				Config: testAccSeriesBulkResourceConfig("true", "sonarr_series.bulk_one.id"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sonarr_series_bulk.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series_bulk.test", "series_ids.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSeriesBulkResourceConfig(monitored, ids string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "bulk_one" {
+		title      = "Cowboy Bebop"
+		title_slug = "cowboy-bebop"
+		tvdb_id    = 76885
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/cowboy-bebop"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		lifecycle {
+			ignore_changes = [monitored, series_type]
+		}
+	}
+
+	resource "sonarr_series" "bulk_two" {
+		title      = "Samurai Champloo"
+		title_slug = "samurai-champloo"
+		tvdb_id    = 79089
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/samurai-champloo"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		lifecycle {
+			ignore_changes = [monitored, series_type]
+		}
+	}
+
+	resource "sonarr_series_bulk" "test" {
+		series_ids  = [%s]
+		monitored   = %s
+		series_type = "anime"
+	}
+	`, ids, monitored)
+}
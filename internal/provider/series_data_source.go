@@ -80,6 +80,123 @@ func (d *SeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type.",
+				Computed:            true,
+			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new seasons.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Series status.",
+				Computed:            true,
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Series network.",
+				Computed:            true,
+			},
+			"imdb_id": schema.StringAttribute{
+				MarkdownDescription: "IMDB ID.",
+				Computed:            true,
+			},
+			"tvmaze_id": schema.Int64Attribute{
+				MarkdownDescription: "TVMaze ID.",
+				Computed:            true,
+			},
+			"year": schema.Int64Attribute{
+				MarkdownDescription: "Series year.",
+				Computed:            true,
+			},
+			"genres": schema.SetAttribute{
+				MarkdownDescription: "List of genres.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "List of images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: d.getImageSchema().Attributes,
+				},
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Series statistics.",
+				Computed:            true,
+				Attributes:          d.getStatisticsSchema().Attributes,
+			},
+			"seasons": schema.SetNestedAttribute{
+				MarkdownDescription: "Seasons monitoring.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: d.getSeasonSchema().Attributes,
+				},
+			},
+		},
+	}
+}
+
+func (d SeriesDataSource) getImageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cover_type": schema.StringAttribute{
+				MarkdownDescription: "Cover type.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Image URL.",
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Image remote URL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d SeriesDataSource) getSeasonSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Season number.",
+				Computed:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d SeriesDataSource) getStatisticsSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"season_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of seasons.",
+				Computed:            true,
+			},
+			"episode_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of monitored episodes.",
+				Computed:            true,
+			},
+			"episode_file_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of episode files.",
+				Computed:            true,
+			},
+			"total_episode_count": schema.Int64Attribute{
+				MarkdownDescription: "Total number of episodes.",
+				Computed:            true,
+			},
+			"size_on_disk": schema.Int64Attribute{
+				MarkdownDescription: "Size on disk in bytes.",
+				Computed:            true,
+			},
+			"percent_of_episodes": schema.Float64Attribute{
+				MarkdownDescription: "Percentage of monitored episodes with a file.",
+				Computed:            true,
+			},
 		},
 	}
 }
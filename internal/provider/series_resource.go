@@ -2,27 +2,53 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"reflect"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const seriesResourceName = "series"
+const (
+	seriesResourceName = "series"
+	// managedSeasonsKey records in private state whether the seasons are configured.
+	managedSeasonsKey = "managed_seasons"
+)
+
+// Defaults of the add options, whether the block is omitted or partly set.
+// Missing episodes are searched right away, as before the options were introduced.
+const (
+	addOptionsMonitor = sonarr.MONITORTYPES_ALL
+	addOptionsSearch  = true
+	addOptionsIgnore  = false
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &SeriesResource{}
 	_ resource.ResourceWithImportState = &SeriesResource{}
+	_ resource.ResourceWithModifyPlan  = &SeriesResource{}
 )
 
 func NewSeriesResource() resource.Resource {
@@ -33,18 +59,31 @@ func NewSeriesResource() resource.Resource {
 type SeriesResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
+	adopt  bool
 }
 
 // Series describes the series data model.
 type Series struct {
+	Statistics        types.Object `tfsdk:"statistics"`
 	Tags              types.Set    `tfsdk:"tags"`
+	Seasons           types.Set    `tfsdk:"seasons"`
+	Genres            types.Set    `tfsdk:"genres"`
+	Images            types.Set    `tfsdk:"images"`
 	Path              types.String `tfsdk:"path"`
 	Title             types.String `tfsdk:"title"`
 	TitleSlug         types.String `tfsdk:"title_slug"`
 	RootFolderPath    types.String `tfsdk:"root_folder_path"`
+	SeriesType        types.String `tfsdk:"series_type"`
+	MonitorNewItems   types.String `tfsdk:"monitor_new_items"`
+	Status            types.String `tfsdk:"status"`
+	Network           types.String `tfsdk:"network"`
+	ImdbID            types.String `tfsdk:"imdb_id"`
 	ID                types.Int64  `tfsdk:"id"`
 	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
 	TvdbID            types.Int64  `tfsdk:"tvdb_id"`
+	TvmazeID          types.Int64  `tfsdk:"tvmaze_id"`
+	Year              types.Int64  `tfsdk:"year"`
 	Monitored         types.Bool   `tfsdk:"monitored"`
 	SeasonFolder      types.Bool   `tfsdk:"season_folder"`
 	UseSceneNumbering types.Bool   `tfsdk:"use_scene_numbering"`
@@ -59,26 +98,54 @@ func (s Series) getType() attr.Type {
 			"id":                  types.Int64Type,
 			"quality_profile_id":  types.Int64Type,
 			"tvdb_id":             types.Int64Type,
+			"tvmaze_id":           types.Int64Type,
+			"year":                types.Int64Type,
 			"root_folder_path":    types.StringType,
 			"title_slug":          types.StringType,
 			"title":               types.StringType,
 			"path":                types.StringType,
+			"series_type":         types.StringType,
+			"monitor_new_items":   types.StringType,
+			"status":              types.StringType,
+			"network":             types.StringType,
+			"imdb_id":             types.StringType,
 			"tags":                types.SetType{}.WithElementType(types.Int64Type),
+			"seasons":             types.SetType{}.WithElementType(Season{}.getType()),
+			"genres":              types.SetType{}.WithElementType(types.StringType),
+			"images":              types.SetType{}.WithElementType(Image{}.getType()),
+			"statistics":          SeriesStatistics{}.getType(),
 		})
 }
 
+// ManagedSeries describes the series resource data model.
+// It extends Series with the attributes that are not exposed by the data sources.
+type ManagedSeries struct {
+	Series
+	AddOptions types.Object `tfsdk:"add_options"`
+	Term       types.String `tfsdk:"term"`
+}
+
 // Season is part of Series.
 type Season struct {
 	Monitored    types.Bool  `tfsdk:"monitored"`
 	SeasonNumber types.Int64 `tfsdk:"season_number"`
 }
 
+func (s Season) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"monitored":     types.BoolType,
+			"season_number": types.Int64Type,
+		})
+}
+
 // AddSeriesOptions is used in series creation.
 type AddSeriesOptions struct {
-	SearchForMissingEpisodes     types.Bool `tfsdk:"search_for_missing_episodes"`
-	SearchForCutoffUnmetEpisodes types.Bool `tfsdk:"search_for_cutoff_unmet_episodes"`
-	IgnoreEpisodesWithFiles      types.Bool `tfsdk:"ignore_episodes_with_files"`
-	IgnoreEpisodesWithoutFiles   types.Bool `tfsdk:"ignore_episodes_without_files"`
+	Monitor                      types.String `tfsdk:"monitor"`
+	SearchForMissingEpisodes     types.Bool   `tfsdk:"search_for_missing_episodes"`
+	SearchForCutoffUnmetEpisodes types.Bool   `tfsdk:"search_for_cutoff_unmet_episodes"`
+	IgnoreEpisodesWithFiles      types.Bool   `tfsdk:"ignore_episodes_with_files"`
+	IgnoreEpisodesWithoutFiles   types.Bool   `tfsdk:"ignore_episodes_without_files"`
 }
 
 // Image is part of Series.
@@ -86,7 +153,37 @@ type Image struct {
 	CoverType types.String `tfsdk:"cover_type"`
 	URL       types.String `tfsdk:"url"`
 	RemoteURL types.String `tfsdk:"remote_url"`
-	Extension types.String `tfsdk:"extension"`
+}
+
+func (i Image) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"cover_type": types.StringType,
+			"url":        types.StringType,
+			"remote_url": types.StringType,
+		})
+}
+
+// SeriesStatistics is part of Series.
+type SeriesStatistics struct {
+	SizeOnDisk        types.Int64   `tfsdk:"size_on_disk"`
+	SeasonCount       types.Int64   `tfsdk:"season_count"`
+	EpisodeCount      types.Int64   `tfsdk:"episode_count"`
+	EpisodeFileCount  types.Int64   `tfsdk:"episode_file_count"`
+	TotalEpisodeCount types.Int64   `tfsdk:"total_episode_count"`
+	PercentOfEpisodes types.Float64 `tfsdk:"percent_of_episodes"`
+}
+
+func (s SeriesStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"size_on_disk":        types.Int64Type,
+			"season_count":        types.Int64Type,
+			"episode_count":       types.Int64Type,
+			"episode_file_count":  types.Int64Type,
+			"total_episode_count": types.Int64Type,
+			"percent_of_episodes": types.Float64Type,
+		})
 }
 
 func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -94,17 +191,32 @@ func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	// TODO: waiting to implement seasons and images until empty conversion is managed natively https://www.terraform.io/plugin/framework/accessing-values#conversion-rules
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Series -->\nSeries resource.\nFor more information refer to [Series](https://wiki.servarr.com/sonarr/library#series) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"term": schema.StringAttribute{
+				MarkdownDescription: "Search term used to resolve `tvdb_id`, `title` and `title_slug` at plan time. It also accepts `imdb:<IMDB ID>` and `tmdb:<TMDB ID>`. A term matching more than one series fails, listing the candidates. Exactly one of `term` or `tvdb_id` must be set, `title` and `title_slug` cannot be set along with it.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("tvdb_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("title"), path.MatchRoot("title_slug")),
+				},
+			},
 			"title": schema.StringAttribute{
 				MarkdownDescription: "Series Title.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"title_slug": schema.StringAttribute{
 				MarkdownDescription: "Series Title in kebab format.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"monitored": schema.BoolAttribute{
 				MarkdownDescription: "Monitored flag.",
@@ -123,8 +235,15 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:            true,
 			},
 			"tvdb_id": schema.Int64Attribute{
-				MarkdownDescription: "TVDB ID.",
-				Required:            true,
+				MarkdownDescription: "TVDB ID. If set, `title` and `title_slug` must be set too.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("title"), path.MatchRoot("title_slug")),
+				},
 			},
 			"path": schema.StringAttribute{
 				MarkdownDescription: "Series Path.",
@@ -140,6 +259,113 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type. Valid values are: `standard`, `daily`, `anime`. Defaults to the Sonarr value when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sonarr.SERIESTYPES_STANDARD),
+						string(sonarr.SERIESTYPES_DAILY),
+						string(sonarr.SERIESTYPES_ANIME),
+					),
+				},
+			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new seasons. Valid values are: `all`, `none`. Defaults to the Sonarr value when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(sonarr.NEWITEMMONITORTYPES_ALL),
+						string(sonarr.NEWITEMMONITORTYPES_NONE),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Series status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Series network.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"imdb_id": schema.StringAttribute{
+				MarkdownDescription: "IMDB ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tvmaze_id": schema.Int64Attribute{
+				MarkdownDescription: "TVMaze ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"year": schema.Int64Attribute{
+				MarkdownDescription: "Series year.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"genres": schema.SetAttribute{
+				MarkdownDescription: "List of genres.",
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "List of images.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: r.getImageSchema().Attributes,
+				},
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Series statistics.",
+				Computed:            true,
+				Attributes:          r.getStatisticsSchema().Attributes,
+			},
+			"seasons": schema.SetNestedAttribute{
+				MarkdownDescription: "Seasons monitoring. Only the listed seasons are managed, the others are left untouched. If unset, all the seasons are read.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Required:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Required:            true,
+						},
+					},
+				},
+			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Series ID.",
 				Computed:            true,
@@ -147,6 +373,104 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"add_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options used only when the series is added. If unset, all episodes are monitored and searched right away. Changing them later is only stored in state and has no effect on the series.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"monitor": schema.StringAttribute{
+						MarkdownDescription: "Episodes to monitor. Valid values are: `all`, `future`, `missing`, `existing`, `pilot`, `firstSeason`, `latestSeason`, `none`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(addOptionsMonitor)),
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(sonarr.MONITORTYPES_ALL),
+								string(sonarr.MONITORTYPES_FUTURE),
+								string(sonarr.MONITORTYPES_MISSING),
+								string(sonarr.MONITORTYPES_EXISTING),
+								string(sonarr.MONITORTYPES_PILOT),
+								string(sonarr.MONITORTYPES_FIRST_SEASON),
+								string(sonarr.MONITORTYPES_LATEST_SEASON),
+								string(sonarr.MONITORTYPES_NONE),
+							),
+						},
+					},
+					"search_for_missing_episodes": schema.BoolAttribute{
+						MarkdownDescription: "Search for missing episodes flag.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(addOptionsSearch),
+					},
+					"search_for_cutoff_unmet_episodes": schema.BoolAttribute{
+						MarkdownDescription: "Search for cutoff unmet episodes flag.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(addOptionsSearch),
+					},
+					"ignore_episodes_with_files": schema.BoolAttribute{
+						MarkdownDescription: "Ignore episodes with files flag.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(addOptionsIgnore),
+					},
+					"ignore_episodes_without_files": schema.BoolAttribute{
+						MarkdownDescription: "Ignore episodes without files flag.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(addOptionsIgnore),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SeriesResource) getImageSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cover_type": schema.StringAttribute{
+				MarkdownDescription: "Cover type.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Image URL.",
+				Computed:            true,
+			},
+			"remote_url": schema.StringAttribute{
+				MarkdownDescription: "Image remote URL.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r SeriesResource) getStatisticsSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"season_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of seasons.",
+				Computed:            true,
+			},
+			"episode_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of monitored episodes.",
+				Computed:            true,
+			},
+			"episode_file_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of episode files.",
+				Computed:            true,
+			},
+			"total_episode_count": schema.Int64Attribute{
+				MarkdownDescription: "Total number of episodes.",
+				Computed:            true,
+			},
+			"size_on_disk": schema.Int64Attribute{
+				MarkdownDescription: "Size on disk in bytes.",
+				Computed:            true,
+			},
+			"percent_of_episodes": schema.Float64Attribute{
+				MarkdownDescription: "Percentage of monitored episodes with a file.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -155,46 +479,204 @@ func (r *SeriesResource) Configure(ctx context.Context, req resource.ConfigureRe
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.adopt = resourceAdopt(req)
+	}
+}
+
+func (r *SeriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy or if the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state *ManagedSeries
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check that referenced objects exist
+	references := newReferences(r.client, r.auth, r.cache)
+	references.validateQualityProfile(path.Root("quality_profile_id"), plan.QualityProfileID, &resp.Diagnostics)
+	references.validateRootFolder(path.Root("root_folder_path"), plan.RootFolderPath, &resp.Diagnostics)
+	references.validateTags(ctx, req.Plan, &resp.Diagnostics)
+
+	// Seasons no longer configured are all read once updated
+	var configSeasons types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("seasons"), &configSeasons)...)
+
+	if managed, _ := req.Private.GetKey(ctx, managedSeasonsKey); state != nil && configSeasons.IsNull() && string(managed) == "true" {
+		plan.Seasons = types.SetUnknown(Season{}.getType())
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("seasons"), plan.Seasons)...)
+	}
+
+	// Lookup only when the term is known and has changed
+	if plan.Term.IsNull() || plan.Term.IsUnknown() || (state != nil && state.Term.Equal(plan.Term)) {
+		return
 	}
+
+	series := r.lookup(plan.Term.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || series == nil {
+		return
+	}
+
+	tflog.Trace(ctx, "resolved "+seriesResourceName+" term '"+plan.Term.ValueString()+"': "+strconv.Itoa(int(series.GetTvdbId())))
+
+	plan.TvdbID = types.Int64Value(int64(series.GetTvdbId()))
+	plan.Title = types.StringValue(series.GetTitle())
+	plan.TitleSlug = types.StringValue(series.GetTitleSlug())
+
+	if state != nil && !state.TvdbID.Equal(plan.TvdbID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("tvdb_id"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// lookup resolves a single series from a search term, failing if the result is ambiguous.
+func (r *SeriesResource) lookup(term string, diags *diag.Diagnostics) *sonarr.SeriesResource {
+	response, _, err := r.client.SeriesLookupAPI.ListSeriesLookup(r.auth).Term(term).Execute()
+	// Lookup is done again once the provider configuration is known
+	if errors.Is(err, helpers.ErrUnknownConfiguration) {
+		return nil
+	}
+
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+
+		return nil
+	}
+
+	switch len(response) {
+	case 0:
+		diags.AddAttributeError(path.Root("term"), helpers.ResourceError, helpers.ParseNotFoundError(seriesResourceName, "term", term))
+
+		return nil
+	case 1:
+		return &response[0]
+	}
+
+	// Identifiers match a single series
+	if strings.HasPrefix(term, "tvdb:") || strings.HasPrefix(term, "imdb:") || strings.HasPrefix(term, "tmdb:") {
+		return &response[0]
+	}
+
+	candidates := make([]string, len(response))
+	for i := range response {
+		candidates[i] = fmt.Sprintf("%s (%d) tvdb_id: %d", response[i].GetTitle(), response[i].GetYear(), response[i].GetTvdbId())
+	}
+
+	diags.AddAttributeError(path.Root("term"), helpers.ResourceError,
+		fmt.Sprintf("Multiple %s found with term '%s', use a more specific term or set tvdb_id. Candidates:\n%s", seriesResourceName, term, strings.Join(candidates, "\n")))
+
+	return nil
 }
 
 func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var series *Series
+	var series *ManagedSeries
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
+	series.configureSeasons(ctx, req.Config, resp.Private, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Create new Series
 	request := series.read(ctx, &resp.Diagnostics)
-	// TODO: can parametrize AddSeriesOptions
-	options := sonarr.NewAddSeriesOptions()
-	options.SetSearchForMissingEpisodes(true)
-	options.SetSearchForCutoffUnmetEpisodes(true)
-	options.SetIgnoreEpisodesWithFiles(false)
-	options.SetIgnoreEpisodesWithoutFiles(false)
 
-	request.SetAddOptions(*options)
+	// Adopt the existing series with the same TVDB ID
+	existing, err := r.findAdoptable(request.GetTvdbId())
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+
+		return
+	}
+
+	if existing != nil {
+		r.adoptSeries(ctx, series, request, existing, req, resp)
+
+		return
+	}
+
+	request.SetAddOptions(*series.readAddOptions(ctx, &resp.Diagnostics))
 
 	response, _, err := r.client.SeriesAPI.CreateSeries(r.auth).SeriesResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, seriesResourceName, err, &resp.Diagnostics)
 
 		return
 	}
 
 	tflog.Trace(ctx, "created "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Save the series right away, so that it stays tracked if the seasons update fails
+	created := *series
+	created.writeManaged(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &created)...)
+
+	// Seasons are known only once the series is added
+	if !series.hasManagedSeasons() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	response.SetSeasons(series.mergeSeasons(ctx, response.GetSeasons(), &resp.Diagnostics))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err = r.client.SeriesAPI.UpdateSeries(r.auth, strconv.Itoa(int(response.GetId()))).SeriesResource(*response).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, seriesResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+seriesResourceName+" seasons: "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	series.writeManaged(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
+}
+
+// adoptSeries updates the existing series to match the plan, as an update would do.
+func (r *SeriesResource) adoptSeries(ctx context.Context, series *ManagedSeries, request, existing *sonarr.SeriesResource, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "adopting existing "+seriesResourceName+": "+strconv.Itoa(int(existing.GetId())))
+	request.SetId(existing.GetId())
+
+	if series.hasManagedSeasons() {
+		request.SetSeasons(series.mergeSeasons(ctx, existing.GetSeasons(), &resp.Diagnostics))
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response, _, err := r.client.SeriesAPI.UpdateSeries(helpers.WithClearedAttributes(r.auth, clearedAttributes(req.Plan)), strconv.Itoa(int(existing.GetId()))).SeriesResource(*request).Execute()
+	if err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, seriesResourceName, err, &resp.Diagnostics)
+
+		return
+	}
+
 	// Generate resource state struct
-	series.write(ctx, response, &resp.Diagnostics)
+	series.writeManaged(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
 func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var series *Series
+	var series *ManagedSeries
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &series)...)
 
@@ -203,22 +685,28 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get series current value
-	response, _, err := r.client.SeriesAPI.GetSeriesById(r.auth, int32(series.ID.ValueInt64())).Execute()
+	response, err := helpers.CachedGet(r.cache, seriesResourceName, int32(series.ID.ValueInt64()), r.listSeries, getSeriesID)
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+		helpers.HandleReadError(ctx, seriesResourceName, err, resp)
 
 		return
 	}
 
 	tflog.Trace(ctx, "read "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
+
+	// Seasons not configured are all read, including the new ones
+	if managed, _ := req.Private.GetKey(ctx, managedSeasonsKey); string(managed) == "false" {
+		series.Seasons = types.SetNull(Season{}.getType())
+	}
+
 	// Map response body to resource schema attribute
-	series.write(ctx, response, &resp.Diagnostics)
+	series.writeManaged(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
 func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var series *Series
+	var series *ManagedSeries
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -226,20 +714,65 @@ func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var state *ManagedSeries
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add options are used only when the series is added
+	if series.onlyAddOptionsChanged(ctx, state, &resp.Diagnostics) {
+		tflog.Trace(ctx, "stored "+seriesResourceName+" add options: "+strconv.Itoa(int(series.ID.ValueInt64())))
+
+		state.AddOptions = series.AddOptions
+		state.Term = series.Term
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+
+		return
+	}
+
+	series.configureSeasons(ctx, req.Config, resp.Private, &resp.Diagnostics)
+
 	// Update Series
 	request := series.read(ctx, &resp.Diagnostics)
 
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *ManagedSeries) any {
+		return prior.read(ctx, &resp.Diagnostics)
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current seasons to keep the unmanaged ones
+	if series.hasManagedSeasons() {
+		current, _, err := r.client.SeriesAPI.GetSeriesById(r.auth, request.GetId()).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+
+			return
+		}
+
+		request.SetSeasons(series.mergeSeasons(ctx, current.GetSeasons(), &resp.Diagnostics))
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// TODO: manage movefiles on sdk
-	response, _, err := r.client.SeriesAPI.UpdateSeries(r.auth, strconv.Itoa(int(request.GetId()))).SeriesResource(*request).Execute()
+	response, _, err := r.client.SeriesAPI.UpdateSeries(auth, strconv.Itoa(int(request.GetId()))).SeriesResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, seriesResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, seriesResourceName, err, &resp.Diagnostics)
 
 		return
 	}
 
 	tflog.Trace(ctx, "updated "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
-	series.write(ctx, response, &resp.Diagnostics)
+	series.writeManaged(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
@@ -266,7 +799,13 @@ func (r *SeriesResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SeriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, seriesResourceName, helpers.TvdbImportKey,
+		r.listSeries,
+		func(item *sonarr.SeriesResource, value string) bool {
+			return strconv.Itoa(int(item.GetTvdbId())) == value
+		},
+		getSeriesID,
+	)
 	tflog.Trace(ctx, "imported "+seriesResourceName+": "+req.ID)
 }
 
@@ -283,8 +822,182 @@ func (s *Series) write(ctx context.Context, series *sonarr.SeriesResource, diags
 	s.Title = types.StringValue(series.GetTitle())
 	s.TitleSlug = types.StringValue(series.GetTitleSlug())
 	s.RootFolderPath = types.StringValue(series.GetRootFolderPath())
+	s.SeriesType = types.StringValue(string(series.GetSeriesType()))
+	s.MonitorNewItems = types.StringValue(string(series.GetMonitorNewItems()))
+	s.Status = types.StringValue(string(series.GetStatus()))
+	s.Network = types.StringValue(series.GetNetwork())
+	s.ImdbID = types.StringValue(series.GetImdbId())
+	s.TvmazeID = types.Int64Value(int64(series.GetTvMazeId()))
+	s.Year = types.Int64Value(int64(series.GetYear()))
 	s.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, series.GetTags())
 	diags.Append(tempDiag...)
+	s.Genres, tempDiag = types.SetValueFrom(ctx, types.StringType, series.GetGenres())
+	diags.Append(tempDiag...)
+
+	seasons := make([]Season, len(series.GetSeasons()))
+	for i, season := range series.GetSeasons() {
+		seasons[i].write(&season)
+	}
+
+	s.Seasons, tempDiag = types.SetValueFrom(ctx, Season{}.getType(), seasons)
+	diags.Append(tempDiag...)
+
+	images := make([]Image, len(series.GetImages()))
+	for i, image := range series.GetImages() {
+		images[i].write(&image)
+	}
+
+	s.Images, tempDiag = types.SetValueFrom(ctx, Image{}.getType(), images)
+	diags.Append(tempDiag...)
+
+	statistics := SeriesStatistics{}
+	statistics.write(series.Statistics)
+	s.Statistics, tempDiag = types.ObjectValueFrom(ctx, statistics.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), statistics)
+	diags.Append(tempDiag...)
+}
+
+func (i *Image) write(image *sonarr.MediaCover) {
+	i.CoverType = types.StringValue(string(image.GetCoverType()))
+	i.URL = types.StringValue(image.GetUrl())
+	i.RemoteURL = types.StringValue(image.GetRemoteUrl())
+}
+
+func (s *SeriesStatistics) write(statistics *sonarr.SeriesStatisticsResource) {
+	s.SizeOnDisk = types.Int64Value(statistics.GetSizeOnDisk())
+	s.SeasonCount = types.Int64Value(int64(statistics.GetSeasonCount()))
+	s.EpisodeCount = types.Int64Value(int64(statistics.GetEpisodeCount()))
+	s.EpisodeFileCount = types.Int64Value(int64(statistics.GetEpisodeFileCount()))
+	s.TotalEpisodeCount = types.Int64Value(int64(statistics.GetTotalEpisodeCount()))
+	s.PercentOfEpisodes = types.Float64Value(statistics.GetPercentOfEpisodes())
+}
+
+func (s *Season) write(season *sonarr.SeasonResource) {
+	s.Monitored = types.BoolValue(season.GetMonitored())
+	s.SeasonNumber = types.Int64Value(int64(season.GetSeasonNumber()))
+}
+
+// writeManaged maps the series keeping in state only the seasons already managed, if any.
+func (s *ManagedSeries) writeManaged(ctx context.Context, series *sonarr.SeriesResource, diags *diag.Diagnostics) {
+	managed := s.managedSeasons(ctx, diags)
+
+	s.write(ctx, series, diags)
+
+	if managed == nil {
+		return
+	}
+
+	seasons := make([]Season, 0, len(managed))
+
+	for _, season := range series.GetSeasons() {
+		if _, ok := managed[int64(season.GetSeasonNumber())]; ok {
+			state := Season{}
+			state.write(&season)
+			seasons = append(seasons, state)
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	s.Seasons, tempDiag = types.SetValueFrom(ctx, Season{}.getType(), seasons)
+	diags.Append(tempDiag...)
+}
+
+// privateSetter is satisfied by the private state of the create and update responses.
+type privateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// configureSeasons manages only the configured seasons, the planned ones come from state otherwise.
+// The choice is kept in private state, so that Read writes every season when none is configured.
+func (s *ManagedSeries) configureSeasons(ctx context.Context, config tfsdk.Config, private privateSetter, diags *diag.Diagnostics) {
+	var seasons types.Set
+
+	diags.Append(config.GetAttribute(ctx, path.Root("seasons"), &seasons)...)
+
+	managed := !seasons.IsNull()
+	if !managed {
+		s.Seasons = types.SetNull(Season{}.getType())
+	}
+
+	diags.Append(private.SetKey(ctx, managedSeasonsKey, []byte(strconv.FormatBool(managed)))...)
+}
+
+func (s *ManagedSeries) hasManagedSeasons() bool {
+	return !s.Seasons.IsNull() && !s.Seasons.IsUnknown()
+}
+
+// managedSeasons returns the configured seasons monitored flag by season number, nil if no season is managed.
+func (s *ManagedSeries) managedSeasons(ctx context.Context, diags *diag.Diagnostics) map[int64]bool {
+	if !s.hasManagedSeasons() {
+		return nil
+	}
+
+	seasons := make([]Season, len(s.Seasons.Elements()))
+	diags.Append(s.Seasons.ElementsAs(ctx, &seasons, false)...)
+
+	managed := make(map[int64]bool, len(seasons))
+	for _, season := range seasons {
+		managed[season.SeasonNumber.ValueInt64()] = season.Monitored.ValueBool()
+	}
+
+	return managed
+}
+
+// mergeSeasons applies the managed seasons on top of the current ones.
+func (s *ManagedSeries) mergeSeasons(ctx context.Context, current []sonarr.SeasonResource, diags *diag.Diagnostics) []sonarr.SeasonResource {
+	managed := s.managedSeasons(ctx, diags)
+	seasons := make([]sonarr.SeasonResource, len(current))
+
+	for i, season := range current {
+		if monitored, ok := managed[int64(season.GetSeasonNumber())]; ok {
+			season.SetMonitored(monitored)
+			delete(managed, int64(season.GetSeasonNumber()))
+		}
+
+		seasons[i] = season
+	}
+
+	for number := range managed {
+		diags.AddAttributeError(path.Root("seasons"), helpers.ResourceError, fmt.Sprintf("Season %d does not exist for series '%s'", number, s.Title.ValueString()))
+	}
+
+	return seasons
+}
+
+// onlyAddOptionsChanged reports whether the update would send the same series as the state,
+// the add options being used only when the series is added.
+func (s *ManagedSeries) onlyAddOptionsChanged(ctx context.Context, state *ManagedSeries, diags *diag.Diagnostics) bool {
+	planned, current := s.read(ctx, diags), state.read(ctx, diags)
+	// unset and empty tags send the same series
+	planned.Tags = append([]int32{}, planned.Tags...)
+	current.Tags = append([]int32{}, current.Tags...)
+
+	return s.Seasons.Equal(state.Seasons) && reflect.DeepEqual(planned, current)
+}
+
+func (s *ManagedSeries) readAddOptions(ctx context.Context, diags *diag.Diagnostics) *sonarr.AddSeriesOptions {
+	options := sonarr.NewAddSeriesOptions()
+
+	// The schema defaults apply when options are not set
+	addOptions := AddSeriesOptions{
+		Monitor:                      types.StringValue(string(addOptionsMonitor)),
+		SearchForMissingEpisodes:     types.BoolValue(addOptionsSearch),
+		SearchForCutoffUnmetEpisodes: types.BoolValue(addOptionsSearch),
+		IgnoreEpisodesWithFiles:      types.BoolValue(addOptionsIgnore),
+		IgnoreEpisodesWithoutFiles:   types.BoolValue(addOptionsIgnore),
+	}
+
+	if !s.AddOptions.IsNull() && !s.AddOptions.IsUnknown() {
+		diags.Append(s.AddOptions.As(ctx, &addOptions, basetypes.ObjectAsOptions{})...)
+	}
+
+	options.SetMonitor(sonarr.MonitorTypes(addOptions.Monitor.ValueString()))
+	options.SetSearchForMissingEpisodes(addOptions.SearchForMissingEpisodes.ValueBool())
+	options.SetSearchForCutoffUnmetEpisodes(addOptions.SearchForCutoffUnmetEpisodes.ValueBool())
+	options.SetIgnoreEpisodesWithFiles(addOptions.IgnoreEpisodesWithFiles.ValueBool())
+	options.SetIgnoreEpisodesWithoutFiles(addOptions.IgnoreEpisodesWithoutFiles.ValueBool())
+
+	return options
 }
 
 func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.SeriesResource {
@@ -297,9 +1010,39 @@ func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Seri
 	series.SetMonitored(s.Monitored.ValueBool())
 	series.SetSeasonFolder(s.SeasonFolder.ValueBool())
 	series.SetPath(s.Path.ValueString())
-	series.SetRootFolderPath(s.Path.ValueString())
+	series.SetRootFolderPath(s.RootFolderPath.ValueString())
 	series.SetUseSceneNumbering(s.UseSceneNumbering.ValueBool())
+	// Sonarr applies its own defaults when unset
+	if !s.SeriesType.IsNull() && !s.SeriesType.IsUnknown() {
+		series.SetSeriesType(sonarr.SeriesTypes(s.SeriesType.ValueString()))
+	}
+
+	if !s.MonitorNewItems.IsNull() && !s.MonitorNewItems.IsUnknown() {
+		series.SetMonitorNewItems(sonarr.NewItemMonitorTypes(s.MonitorNewItems.ValueString()))
+	}
+
 	diags.Append(s.Tags.ElementsAs(ctx, &series.Tags, true)...)
 
 	return series
 }
+
+func (r *SeriesResource) listSeries() ([]sonarr.SeriesResource, error) {
+	response, _, err := r.client.SeriesAPI.ListSeries(r.auth).Execute()
+
+	return response, err
+}
+
+func getSeriesID(item *sonarr.SeriesResource) int32 {
+	return item.GetId()
+}
+
+// findAdoptable returns the series with the same TVDB ID, if adoption is enabled.
+func (r *SeriesResource) findAdoptable(tvdbID int32) (*sonarr.SeriesResource, error) {
+	if !r.adopt {
+		return nil, nil
+	}
+
+	return helpers.CachedFind(r.cache, seriesResourceName, r.listSeries, getSeriesID, func(series *sonarr.SeriesResource) bool {
+		return series.GetTvdbId() == tvdbID
+	})
+}
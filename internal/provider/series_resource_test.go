@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccSeriesResource(t *testing.T) {
@@ -25,6 +26,13 @@ func TestAccSeriesResource(t *testing.T) {
 				Config: testAccSeriesResourceConfig(81189, "Breaking Bad", "breaking-bad", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_series.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "series_type", "standard"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "monitor_new_items", "all"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "status", "ended"),
+					resource.TestCheckResourceAttr("sonarr_series.test", "imdb_id", "tt0903747"),
+					resource.TestCheckResourceAttrSet("sonarr_series.test", "statistics.season_count"),
+					resource.TestCheckNoResourceAttr("sonarr_series.test", "add_options"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.test", "seasons.*", map[string]string{"season_number": "5"}),
 					resource.TestCheckResourceAttrSet("sonarr_series.test", "id"),
 				),
 			},
@@ -46,11 +54,261 @@ func TestAccSeriesResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by TVDB ID testing
+			{
+				ResourceName:      "sonarr_series.test",
+				ImportState:       true,
+				ImportStateId:     "tvdb:81189",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccSeriesResourceConcurrencyCheck(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccConcurrencyCheckProvider + testAccSeriesResourceConfig(176941, "Sherlock", "sherlock", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.test", "root_folder_path", "/config"),
+					resource.TestCheckResourceAttrSet("sonarr_series.test", "id"),
+				),
+			},
+			// Update with unchanged live object
+			{
+				Config: testAccConcurrencyCheckProvider + testAccSeriesResourceConfig(176941, "Sherlock", "sherlock", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.test", "monitored", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccConcurrencyCheckProvider = `
+provider "sonarr" {
+	check_concurrent_changes = true
+}
+`
+
+func TestAccSeriesResourceAddOptions(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceAddOptionsConfig("none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.options", "add_options.monitor", "none"),
+					resource.TestCheckResourceAttr("sonarr_series.options", "add_options.search_for_cutoff_unmet_episodes", "true"),
+					resource.TestCheckResourceAttrSet("sonarr_series.options", "id"),
+				),
+			},
+			// Changed options are only stored
+			{
+				Config: testAccSeriesResourceAddOptionsConfig("pilot"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sonarr_series.options", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.options", "add_options.monitor", "pilot"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSeriesResourceAddOptionsConfig(monitor string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "options" {
+		title      = "Fargo"
+		title_slug = "fargo"
+		tvdb_id    = 269613
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/options"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		add_options = {
+			monitor = "%s"
+		}
+	}
+	`, monitor)
+}
+
+func TestAccSeriesResourceMissingSeason(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing season fails after the series is added
+			{
+				Config:      testAccSeriesResourceSeasonConfig(`seasons = [{ season_number = 5, monitored = false }]`),
+				ExpectError: regexp.MustCompile("Season 5 does not exist"),
+			},
+			// The added series is tracked and replaced
+			{
+				Config: testAccSeriesResourceSeasonConfig(`seasons = [{ season_number = 1, monitored = false }]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.season", "seasons.*", map[string]string{"season_number": "1", "monitored": "false"}),
+					resource.TestCheckResourceAttrSet("sonarr_series.season", "id"),
+				),
+			},
+			// Seasons no longer configured are all read and left untouched
+			{
+				Config: testAccSeriesResourceSeasonConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.season", "seasons.*", map[string]string{"season_number": "1", "monitored": "false"}),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func testAccSeriesResourceSeasonConfig(seasons string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "season" {
+		title      = "Chernobyl"
+		title_slug = "chernobyl"
+		tvdb_id    = 360893
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/season"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		%s
+	}
+	`, seasons)
+}
+
+func TestAccSeriesResourceRootFolder(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the root folder along with the series
+			{
+				Config: testAccSeriesResourceRootFolderConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.root_folder", "root_folder_path", "/config/shows"),
+					resource.TestCheckResourceAttrSet("sonarr_series.root_folder", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccSeriesResourceRootFolderConfig = `
+	resource "sonarr_root_folder" "series" {
+		path = "/config/shows"
+	}
+
+	resource "sonarr_series" "root_folder" {
+		title      = "Firefly"
+		title_slug = "firefly"
+		tvdb_id    = 78874
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/shows/firefly"
+		root_folder_path    = sonarr_root_folder.series.path
+
+		quality_profile_id  = 1
+	}
+`
+
+func TestAccSeriesResourceLookup(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Term along with title
+			{
+				Config:      testAccSeriesResourceLookupConfig("imdb:tt0386676") + testAccSeriesResourceLookupTitleConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Ambiguous term
+			{
+				Config:      testAccSeriesResourceLookupConfig("Office"),
+				ExpectError: regexp.MustCompile("Multiple series found"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceLookupConfig("imdb:tt0386676"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "tvdb_id", "73244"),
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "title_slug", "the-office-us"),
+					resource.TestCheckResourceAttrSet("sonarr_series.lookup", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccSeriesResourceLookupTitleConfig = `
+	resource "sonarr_series" "conflict" {
+		term       = "imdb:tt0386676"
+		title      = "The Office (US)"
+		title_slug = "the-office-us"
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/conflict"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+	}
+`
+
+func testAccSeriesResourceLookupConfig(term string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "lookup" {
+		term = "%s"
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/lookup"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+	}
+	`, term)
+}
+
 func testAccSeriesResourceConfig(id int, title, slug, monitored string) string {
 	return fmt.Sprintf(`
 	resource "sonarr_series" "test" {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -36,6 +37,8 @@ func NewTagResource() resource.Resource {
 type TagResource struct {
 	client *sonarr.APIClient
 	auth   context.Context
+	cache  *helpers.ReadCache
+	adopt  bool
 }
 
 // Tag describes the tag data model.
@@ -85,6 +88,8 @@ func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureReque
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
 		r.auth = auth
+		r.cache = resourceCache(req)
+		r.adopt = resourceAdopt(req)
 	}
 }
 
@@ -102,9 +107,26 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	request := *sonarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	// Adopt the existing tag with the same label
+	existing, err := r.findAdoptable(request.GetLabel())
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+
+		return
+	}
+
+	var response *sonarr.TagResource
+
+	if existing != nil {
+		tflog.Info(ctx, "adopting existing "+tagResourceName+": "+strconv.Itoa(int(existing.GetId())))
+		request.SetId(existing.GetId())
+		response, _, err = r.client.TagAPI.UpdateTag(r.auth, strconv.Itoa(int(existing.GetId()))).TagResource(request).Execute()
+	} else {
+		response, _, err = r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	}
+
+	if err != nil {
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Create, tagResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -128,7 +150,7 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	// Get tag current value
 	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+		helpers.HandleReadError(ctx, tagResourceName, err, resp)
 
 		return
 	}
@@ -154,9 +176,20 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tagResource.SetLabel(tag.Label.ValueString())
 	tagResource.SetId(int32(tag.ID.ValueInt64()))
 
-	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
+	auth := updateContext(ctx, r.auth, req, &resp.Diagnostics, func(prior *Tag) any {
+		priorResource := sonarr.NewTagResource()
+		priorResource.SetLabel(prior.Label.ValueString())
+
+		return priorResource
+	})
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, _, err := r.client.TagAPI.UpdateTag(auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.AddClientError(ctx, req.Plan.Schema, helpers.Update, tagResourceName, err, &resp.Diagnostics)
 
 		return
 	}
@@ -189,7 +222,13 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStateWithKey(ctx, path.Root("id"), req, resp, tagResourceName, helpers.LabelImportKey,
+		r.listTags,
+		func(item *sonarr.TagResource, value string) bool {
+			return strings.EqualFold(item.GetLabel(), value)
+		},
+		getTagID,
+	)
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
@@ -197,3 +236,24 @@ func (t *Tag) write(tag *sonarr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
 	t.Label = types.StringValue(tag.GetLabel())
 }
+
+// findAdoptable returns the tag with the same label, if adoption is enabled.
+func (r *TagResource) findAdoptable(label string) (*sonarr.TagResource, error) {
+	if !r.adopt {
+		return nil, nil
+	}
+
+	return helpers.CachedFind(r.cache, tagResourceName, r.listTags, getTagID, func(tag *sonarr.TagResource) bool {
+		return strings.EqualFold(tag.GetLabel(), label)
+	})
+}
+
+func (r *TagResource) listTags() ([]sonarr.TagResource, error) {
+	response, _, err := r.client.TagAPI.ListTag(r.auth).Execute()
+
+	return response, err
+}
+
+func getTagID(item *sonarr.TagResource) int32 {
+	return item.GetId()
+}
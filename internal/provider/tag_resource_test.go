@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -47,11 +49,58 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by label testing
+			{
+				ResourceName:      "sonarr_tag.test",
+				ImportState:       true,
+				ImportStateId:     "label:1080p",
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestAccTagResourceAdopt(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Duplicate testing
+			{
+				PreConfig:   tagAdoptInit,
+				Config:      testAccTagResourceConfig("adopt", "adopted"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Adopt testing
+			{
+				Config: testAccAdoptProvider + testAccTagResourceConfig("adopt", "adopted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_tag.adopt", "label", "adopted"),
+					resource.TestCheckResourceAttrSet("sonarr_tag.adopt", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccAdoptProvider = `
+provider "sonarr" {
+	adopt_existing = true
+}
+`
+
+func tagAdoptInit() {
+	// ensure the tag exists before create
+	client := testAccAPIClient()
+	tag := sonarr.NewTagResource()
+	tag.SetLabel("adopted")
+	_, _, _ = client.TagAPI.CreateTag(context.TODO()).TagResource(*tag).Execute()
+}
+
 func testAccTagResourceConfig(name, label string) string {
 	return fmt.Sprintf(`
 		resource "sonarr_tag" "%s" {
@@ -0,0 +1,155 @@
+package testserver
+
+import "strings"
+
+// Version is the Sonarr version reported by the fake server.
+const Version = "4.0.10.2544"
+
+// collectionNames lists the generic CRUD endpoints served by the fake server.
+var collectionNames = []string{
+	"autotagging",
+	"customformat",
+	"delayprofile",
+	"downloadclient",
+	"episode",
+	"importlist",
+	"importlistexclusion",
+	"indexer",
+	"language",
+	"metadata",
+	"notification",
+	"qualitydefinition",
+	"qualityprofile",
+	"releaseprofile",
+	"remotepathmapping",
+	"rootfolder",
+	"series",
+	"tag",
+}
+
+// quality describes a seeded Sonarr quality.
+type quality struct {
+	name       string
+	source     string
+	id         int
+	resolution int
+}
+
+// qualities are ordered as the quality definitions of a fresh installation.
+var qualities = []quality{
+	{id: 0, name: "Unknown", source: "unknown", resolution: 0},
+	{id: 1, name: "SDTV", source: "television", resolution: 480},
+	{id: 12, name: "WEBRip-480p", source: "webRip", resolution: 480},
+	{id: 8, name: "WEBDL-480p", source: "web", resolution: 480},
+	{id: 2, name: "DVD", source: "dvd", resolution: 480},
+	{id: 13, name: "Bluray-480p", source: "bluray", resolution: 480},
+	{id: 4, name: "HDTV-720p", source: "television", resolution: 720},
+	{id: 9, name: "HDTV-1080p", source: "television", resolution: 1080},
+	{id: 10, name: "Raw-HD", source: "televisionRaw", resolution: 1080},
+	{id: 14, name: "WEBRip-720p", source: "webRip", resolution: 720},
+	{id: 5, name: "WEBDL-720p", source: "web", resolution: 720},
+	{id: 6, name: "Bluray-720p", source: "bluray", resolution: 720},
+	{id: 15, name: "WEBRip-1080p", source: "webRip", resolution: 1080},
+	{id: 3, name: "WEBDL-1080p", source: "web", resolution: 1080},
+	{id: 7, name: "Bluray-1080p", source: "bluray", resolution: 1080},
+	{id: 20, name: "Bluray-1080p Remux", source: "blurayRaw", resolution: 1080},
+	{id: 16, name: "HDTV-2160p", source: "television", resolution: 2160},
+	{id: 17, name: "WEBRip-2160p", source: "webRip", resolution: 2160},
+	{id: 18, name: "WEBDL-2160p", source: "web", resolution: 2160},
+	{id: 19, name: "Bluray-2160p", source: "bluray", resolution: 2160},
+	{id: 21, name: "Bluray-2160p Remux", source: "blurayRaw", resolution: 2160},
+}
+
+var languages = []string{"Unknown", "English", "French", "Spanish", "German", "Italian", "Danish", "Dutch", "Japanese", "Icelandic", "Chinese", "Russian", "Polish"}
+
+func (q quality) object() object {
+	return object{"id": q.id, "name": q.name, "source": q.source, "resolution": q.resolution}
+}
+
+func systemStatus() object {
+	return object{
+		"appName":      "Sonarr",
+		"instanceName": "Sonarr",
+		"version":      Version,
+		"isProduction": true,
+		"isDocker":     true,
+		"isLinux":      true,
+		"urlBase":      "",
+	}
+}
+
+// seed populates the objects found in a fresh installation.
+func (s *Server) seed() {
+	for _, name := range collectionNames {
+		s.collections[name] = make(map[int]object)
+	}
+
+	s.singletons["host"] = object{
+		"id": 1, "bindAddress": "*", "port": 8989, "sslPort": 9898, "enableSsl": false, "launchBrowser": true,
+		"authenticationMethod": "none", "authenticationRequired": "enabled", "analyticsEnabled": false,
+		"username": "", "password": "", "passwordConfirmation": "", "logLevel": "info", "consoleLogLevel": "",
+		"branch": "main", "apiKey": APIKey, "sslCertPath": "", "sslCertPassword": "", "urlBase": "", "instanceName": "Sonarr",
+		"applicationUrl": "", "updateAutomatically": false, "updateMechanism": "docker", "updateScriptPath": "",
+		"proxyEnabled": false, "proxyType": "http", "proxyHostname": "", "proxyPort": 8080, "proxyUsername": "",
+		"proxyPassword": "", "proxyBypassFilter": "", "proxyBypassLocalAddresses": true, "certificateValidation": "enabled",
+		"backupFolder": "Backups", "backupInterval": 7, "backupRetention": 28,
+	}
+	s.singletons["naming"] = object{
+		"id": 1, "renameEpisodes": false, "replaceIllegalCharacters": true, "colonReplacementFormat": 4, "multiEpisodeStyle": 0,
+		"standardEpisodeFormat":        "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		"dailyEpisodeFormat":           "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}",
+		"animeEpisodeFormat":           "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		"seriesFolderFormat":           "{Series Title}",
+		"seasonFolderFormat":           "Season {season}",
+		"specialsFolderFormat":         "Specials",
+		"customColonReplacementFormat": "",
+	}
+	s.singletons["mediamanagement"] = object{
+		"id": 1, "autoUnmonitorPreviouslyDownloadedEpisodes": false, "recycleBin": "", "recycleBinCleanupDays": 7,
+		"downloadPropersAndRepacks": "preferAndUpgrade", "createEmptySeriesFolders": false, "deleteEmptyFolders": false,
+		"fileDate": "none", "rescanAfterRefresh": "always", "setPermissionsLinux": false, "chmodFolder": "755", "chownGroup": "",
+		"episodeTitleRequired": "always", "skipFreeSpaceCheckWhenImporting": false, "minimumFreeSpaceWhenImporting": 100,
+		"copyUsingHardlinks": true, "useScriptImport": false, "scriptImportPath": "", "importExtraFiles": false,
+		"extraFileExtensions": "srt", "enableMediaInfo": true,
+	}
+	s.singletons["downloadclient"] = object{
+		"id": 1, "downloadClientWorkingFolders": "_UNPACK_|_FAILED_", "enableCompletedDownloadHandling": true,
+		"autoRedownloadFailed": true, "autoRedownloadFailedFromInteractiveSearch": true,
+	}
+	s.singletons["indexer"] = object{"id": 1, "minimumAge": 0, "retention": 0, "maximumSize": 0, "rssSyncInterval": 15}
+	s.singletons["importlist"] = object{"id": 1, "listSyncLevel": "disabled", "listSyncTag": 0}
+	s.singletons["ui"] = object{"id": 1, "firstDayOfWeek": 0, "calendarWeekColumnHeader": "ddd M/D", "uiLanguage": 1, "theme": "auto"}
+
+	items := make([]interface{}, len(qualities))
+
+	for i, q := range qualities {
+		s.insert("qualitydefinition", object{
+			"quality": q.object(), "title": q.name, "weight": i + 1,
+			"minSize": 0, "maxSize": 1000, "preferredSize": 995,
+		})
+
+		items[i] = object{"quality": q.object(), "items": []interface{}{}, "allowed": true}
+	}
+
+	for i, name := range languages {
+		s.collections["language"][i] = object{"id": i, "name": name, "nameLower": strings.ToLower(name)}
+	}
+
+	s.insert("qualityprofile", object{
+		"name": "Any", "upgradeAllowed": false, "cutoff": 1, "items": items,
+		"minFormatScore": 0, "cutoffFormatScore": 0, "minUpgradeFormatScore": 1, "formatItems": []interface{}{},
+	})
+	s.insert("delayprofile", object{
+		"enableUsenet": true, "enableTorrent": true, "preferredProtocol": "usenet", "usenetDelay": 0, "torrentDelay": 0,
+		"bypassIfHighestQuality": true, "bypassIfAboveCustomFormatScore": false, "minimumCustomFormatScore": 0,
+		"order": 2147483647, "tags": []interface{}{},
+	})
+
+	for _, consumer := range [][2]string{{"Kodi (XBMC) / Emby", "XbmcMetadata"}, {"Roksbox", "RoksboxMetadata"}, {"WDTV", "WdtvMetadata"}} {
+		s.insert("metadata", object{
+			"name": consumer[0], "implementation": consumer[1], "implementationName": consumer[0],
+			"configContract": consumer[1] + "Settings", "enable": false, "tags": []interface{}{},
+			"fields": []interface{}{object{"name": "episodeMetadata", "value": false}},
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package testserver
+
+import (
+	"strings"
+)
+
+// maskedValue replaces the private field values in the responses, as Sonarr does.
+const maskedValue = "********"
+
+// implementation is a provider template returned by the schema endpoints.
+type implementation struct {
+	name     string
+	contract string
+	protocol string
+	fields   []string
+}
+
+// implementations lists the providers of each collection, with the fields of their settings.
+var implementations = map[string][]implementation{
+	"downloadclient": {
+		{name: "Aria2", contract: "Aria2Settings", protocol: "torrent", fields: []string{"host", "port", "rpcPath", "secretToken", "useSsl"}},
+		{name: "Deluge", contract: "DelugeSettings", protocol: "torrent", fields: []string{"addPaused", "host", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "tvImportedCategory", "urlBase", "useSsl"}},
+		{name: "Flood", contract: "FloodSettings", protocol: "torrent", fields: []string{"additionalTags", "destination", "host", "password", "port", "postImportTags", "startOnAdd", "tags", "urlBase", "useSsl", "username"}},
+		{name: "Hadouken", contract: "HadoukenSettings", protocol: "torrent", fields: []string{"category", "host", "password", "port", "urlBase", "useSsl", "username"}},
+		{name: "Nzbget", contract: "NzbgetSettings", protocol: "usenet", fields: []string{"addPaused", "host", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "urlBase", "useSsl", "username"}},
+		{name: "Nzbvortex", contract: "NzbvortexSettings", protocol: "usenet", fields: []string{"apiKey", "host", "olderTvPriority", "port", "recentTvPriority", "tvCategory", "urlBase"}},
+		{name: "Pneumatic", contract: "PneumaticSettings", protocol: "usenet", fields: []string{"nzbFolder", "strmFolder"}},
+		{name: "QBittorrent", contract: "QBittorrentSettings", protocol: "torrent", fields: []string{"firstAndLast", "host", "initialState", "olderTvPriority", "password", "port", "recentTvPriority", "sequentialOrder", "tvCategory", "tvImportedCategory", "urlBase", "useSsl", "username"}},
+		{name: "RTorrent", contract: "RTorrentSettings", protocol: "torrent", fields: []string{"addStopped", "host", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "tvDirectory", "tvImportedCategory", "urlBase", "useSsl", "username"}},
+		{name: "Sabnzbd", contract: "SabnzbdSettings", protocol: "usenet", fields: []string{"apiKey", "host", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "urlBase", "useSsl", "username"}},
+		{name: "TorrentBlackhole", contract: "TorrentBlackholeSettings", protocol: "torrent", fields: []string{"magnetFileExtension", "readOnly", "saveMagnetFiles", "torrentFolder", "watchFolder"}},
+		{name: "TorrentDownloadStation", contract: "DownloadStationSettings", protocol: "torrent", fields: []string{"host", "password", "port", "tvCategory", "tvDirectory", "useSsl", "username"}},
+		{name: "Transmission", contract: "TransmissionSettings", protocol: "torrent", fields: []string{"addPaused", "host", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "tvDirectory", "urlBase", "useSsl", "username"}},
+		{name: "UTorrent", contract: "UTorrentSettings", protocol: "torrent", fields: []string{"host", "intialState", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "tvImportedCategory", "urlBase", "useSsl", "username"}},
+		{name: "UsenetBlackhole", contract: "UsenetBlackholeSettings", protocol: "usenet", fields: []string{"nzbFolder", "watchFolder"}},
+		{name: "UsenetDownloadStation", contract: "DownloadStationSettings", protocol: "usenet", fields: []string{"host", "password", "port", "tvCategory", "tvDirectory", "useSsl", "username"}},
+		{name: "Vuze", contract: "TransmissionSettings", protocol: "torrent", fields: []string{"addPaused", "host", "olderTvPriority", "password", "port", "recentTvPriority", "tvCategory", "tvDirectory", "urlBase", "useSsl", "username"}},
+	},
+	"importlist": {
+		{name: "CustomImport", contract: "CustomSettings", fields: []string{"baseUrl"}},
+		{name: "ImdbListImport", contract: "ImdbListSettings", fields: []string{"listId"}},
+		{name: "PlexImport", contract: "PlexListSettings", fields: []string{"accessToken"}},
+		{name: "PlexRssImport", contract: "PlexRssImportSettings", fields: []string{"url"}},
+		{name: "SimklUserImport", contract: "SimklUserSettings", fields: []string{"accessToken", "authUser", "expires", "listType", "refreshToken"}},
+		{name: "SonarrImport", contract: "SonarrSettings", fields: []string{"apiKey", "baseUrl", "languageProfileIDs", "tagIds"}},
+		{name: "TraktListImport", contract: "TraktListSettings", fields: []string{"accessToken", "authUser", "expires", "limit", "listname", "refreshToken", "traktAdditionalParameters", "username"}},
+		{name: "TraktPopularImport", contract: "TraktPopularSettings", fields: []string{"accessToken", "authUser", "expires", "genres", "limit", "rating", "refreshToken", "traktAdditionalParameters", "traktListType", "years"}},
+		{name: "TraktUserImport", contract: "TraktUserSettings", fields: []string{"accessToken", "authUser", "expires", "limit", "refreshToken", "traktAdditionalParameters", "traktListType", "username"}},
+	},
+	"indexer": {
+		{name: "BroadcastheNet", contract: "BroadcastheNetSettings", protocol: "torrent", fields: []string{"apiKey", "baseUrl", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime"}},
+		{name: "Fanzub", contract: "FanzubSettings", protocol: "usenet", fields: []string{"animeStandardFormatSearch", "baseUrl"}},
+		{name: "FileList", contract: "FileListSettings", protocol: "torrent", fields: []string{"animeCategories", "baseUrl", "categories", "minimumSeeders", "passkey", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime", "username"}},
+		{name: "HDBits", contract: "HDBitsSettings", protocol: "torrent", fields: []string{"apiKey", "baseUrl", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime", "username"}},
+		{name: "IPTorrents", contract: "IPTorrentsSettings", protocol: "torrent", fields: []string{"baseUrl", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime"}},
+		{name: "Newznab", contract: "NewznabSettings", protocol: "usenet", fields: []string{"additionalParameters", "animeCategories", "animeStandardFormatSearch", "apiKey", "apiPath", "baseUrl", "categories"}},
+		{name: "Nyaa", contract: "NyaaSettings", protocol: "torrent", fields: []string{"additionalParameters", "animeStandardFormatSearch", "baseUrl", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime"}},
+		{name: "TorrentRssIndexer", contract: "TorrentRssIndexerSettings", protocol: "torrent", fields: []string{"allowZeroSize", "baseUrl", "cookie", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime"}},
+		{name: "Torrentleech", contract: "TorrentleechSettings", protocol: "torrent", fields: []string{"apiKey", "baseUrl", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime"}},
+		{name: "Torznab", contract: "TorznabSettings", protocol: "torrent", fields: []string{"additionalParameters", "animeCategories", "animeStandardFormatSearch", "apiKey", "apiPath", "baseUrl", "categories", "minimumSeeders", "seedCriteria.seasonPackSeedTime", "seedCriteria.seedRatio", "seedCriteria.seedTime"}},
+	},
+	"metadata": {
+		{name: "RoksboxMetadata", contract: "RoksboxMetadataSettings", fields: []string{"episodeImages", "episodeMetadata", "seasonImages", "seriesImages"}},
+		{name: "WdtvMetadata", contract: "WdtvMetadataSettings", fields: []string{"episodeImages", "episodeMetadata", "seasonImages", "seriesImages"}},
+		{name: "XbmcMetadata", contract: "XbmcMetadataSettings", fields: []string{"episodeImages", "episodeMetadata", "seasonImages", "seriesImages", "seriesMetadata", "seriesMetadataUrl"}},
+	},
+	"notification": {
+		{name: "Apprise", contract: "AppriseSettings", fields: []string{"authPassword", "authUsername", "configurationKey", "notificationType", "serverUrl", "statelessUrls", "tags"}},
+		{name: "CustomScript", contract: "CustomScriptSettings", fields: []string{"arguments", "path"}},
+		{name: "Discord", contract: "DiscordSettings", fields: []string{"author", "avatar", "grabFields", "importFields", "username", "webHookUrl"}},
+		{name: "Email", contract: "EmailSettings", fields: []string{"bcc", "cc", "from", "password", "port", "server", "to", "useEncryption", "username"}},
+		{name: "Gotify", contract: "GotifySettings", fields: []string{"appToken", "priority", "server"}},
+		{name: "Join", contract: "JoinSettings", fields: []string{"apiKey", "deviceNames", "priority"}},
+		{name: "Mailgun", contract: "MailgunSettings", fields: []string{"apiKey", "from", "recipients", "senderDomain", "useEuEndpoint"}},
+		{name: "MediaBrowser", contract: "MediaBrowserSettings", fields: []string{"apiKey", "host", "notify", "port", "updateLibrary", "useSsl"}},
+		{name: "Ntfy", contract: "NtfySettings", fields: []string{"accessToken", "clickUrl", "password", "priority", "serverUrl", "tags", "topics", "username"}},
+		{name: "PlexServer", contract: "PlexServerSettings", fields: []string{"authToken", "host", "port", "updateLibrary", "useSsl"}},
+		{name: "Prowl", contract: "ProwlSettings", fields: []string{"apiKey", "priority"}},
+		{name: "PushBullet", contract: "PushBulletSettings", fields: []string{"apiKey", "channelTags", "deviceIds", "senderId"}},
+		{name: "Pushover", contract: "PushoverSettings", fields: []string{"apiKey", "devices", "expire", "priority", "retry", "sound", "userKey"}},
+		{name: "Sendgrid", contract: "SendgridSettings", fields: []string{"apiKey", "from", "recipients"}},
+		{name: "Signal", contract: "SignalSettings", fields: []string{"authPassword", "authUsername", "host", "port", "receiverId", "senderNumber", "useSsl"}},
+		{name: "Simplepush", contract: "SimplepushSettings", fields: []string{"event", "key"}},
+		{name: "Slack", contract: "SlackSettings", fields: []string{"channel", "icon", "username", "webHookUrl"}},
+		{name: "SynologyIndexer", contract: "SynologyIndexerSettings", fields: []string{"updateLibrary"}},
+		{name: "Telegram", contract: "TelegramSettings", fields: []string{"botToken", "chatId", "sendSilently"}},
+		{name: "Trakt", contract: "TraktSettings", fields: []string{"accessToken", "authUser", "expires", "refreshToken"}},
+		{name: "Twitter", contract: "TwitterSettings", fields: []string{"accessToken", "accessTokenSecret", "consumerKey", "consumerSecret", "directMessage", "mention"}},
+		{name: "Webhook", contract: "WebhookSettings", fields: []string{"method", "password", "url", "username"}},
+		{name: "Xbmc", contract: "XbmcSettings", fields: []string{"alwaysUpdate", "cleanLibrary", "displayTime", "host", "notify", "password", "port", "updateLibrary", "useSsl", "username"}},
+	},
+}
+
+// privateFields are the fields whose value is never returned by Sonarr.
+var privateFields = map[string]map[string]bool{
+	"downloadclient": {"apiKey": true, "password": true, "secretToken": true},
+	"indexer":        {"apiKey": true, "passkey": true},
+	"notification": {
+		"accessToken": true, "accessTokenSecret": true, "apiKey": true, "appToken": true, "authPassword": true, "authToken": true,
+		"botToken": true, "consumerKey": true, "consumerSecret": true, "key": true, "password": true, "senderNumber": true,
+	},
+}
+
+func findImplementation(name, implementationName string) (implementation, bool) {
+	for _, i := range implementations[name] {
+		if i.name == implementationName {
+			return i, true
+		}
+	}
+
+	return implementation{}, false
+}
+
+// schema returns the templates used to create a new provider of the collection.
+func schema(name string) []object {
+	templates := make([]object, 0, len(implementations[name]))
+
+	for _, i := range implementations[name] {
+		fields := make([]interface{}, len(i.fields))
+		for n, f := range i.fields {
+			fields[n] = object{"name": f, "value": nil}
+		}
+
+		template := object{"implementation": i.name, "configContract": i.contract, "fields": fields, "tags": []interface{}{}}
+		if i.protocol != "" {
+			template["protocol"] = i.protocol
+		}
+
+		deriveProvider(name, template)
+		templates = append(templates, template)
+	}
+
+	return templates
+}
+
+// deriveProvider sets the values Sonarr computes from the implementation of a provider.
+func deriveProvider(name string, item object) {
+	i, ok := findImplementation(name, stringValue(item["implementation"]))
+	if !ok {
+		return
+	}
+
+	item["implementationName"] = i.name
+	item["infoLink"] = "https://wiki.servarr.com/sonarr/supported#" + strings.ToLower(i.name)
+
+	fields, _ := item["fields"].([]interface{})
+	for _, f := range fields {
+		field, ok := f.(object)
+		if !ok {
+			continue
+		}
+
+		fieldName := stringValue(field["name"])
+		field["label"] = label(fieldName)
+		field["type"] = fieldType(name, fieldName, field["value"])
+		field["privacy"] = privacy(name, fieldName)
+		field["advanced"] = false
+
+		for n, known := range i.fields {
+			if known == fieldName {
+				field["order"] = n
+			}
+		}
+	}
+}
+
+// keepPrivateFields restores the stored value of the private fields sent back masked.
+func keepPrivateFields(name string, current, item object) {
+	stored := make(map[string]interface{})
+
+	currentFields, _ := current["fields"].([]interface{})
+	for _, f := range currentFields {
+		if field, ok := f.(object); ok {
+			stored[stringValue(field["name"])] = field["value"]
+		}
+	}
+
+	fields, _ := item["fields"].([]interface{})
+	for _, f := range fields {
+		field, ok := f.(object)
+		if ok && privateFields[name][stringValue(field["name"])] && field["value"] == maskedValue {
+			field["value"] = stored[stringValue(field["name"])]
+		}
+	}
+}
+
+// mask returns a copy of the response hiding the value of the private fields.
+func mask(name string, response interface{}) interface{} {
+	if len(privateFields[name]) == 0 {
+		return response
+	}
+
+	switch v := response.(type) {
+	case object:
+		return maskObject(name, v)
+	case []object:
+		masked := make([]object, len(v))
+		for i, item := range v {
+			masked[i] = maskObject(name, item)
+		}
+
+		return masked
+	}
+
+	return response
+}
+
+func maskObject(name string, item object) object {
+	fields, ok := item["fields"].([]interface{})
+	if !ok {
+		return item
+	}
+
+	masked := make(object, len(item))
+	for k, v := range item {
+		masked[k] = v
+	}
+
+	maskedFields := make([]interface{}, len(fields))
+	for i, f := range fields {
+		maskedFields[i] = f
+
+		field, ok := f.(object)
+		if !ok || !privateFields[name][stringValue(field["name"])] || stringValue(field["value"]) == "" {
+			continue
+		}
+
+		maskedField := make(object, len(field))
+		for k, v := range field {
+			maskedField[k] = v
+		}
+
+		maskedField["value"] = maskedValue
+		maskedFields[i] = maskedField
+	}
+
+	masked["fields"] = maskedFields
+
+	return masked
+}
+
+func privacy(name, field string) string {
+	switch {
+	case privateFields[name][field] && strings.Contains(strings.ToLower(field), "password"):
+		return "password"
+	case privateFields[name][field]:
+		return "apiKey"
+	case strings.EqualFold(field, "username"):
+		return "userName"
+	}
+
+	return "normal"
+}
+
+func fieldType(name, field string, value interface{}) string {
+	if privacy(name, field) == "password" {
+		return "password"
+	}
+
+	switch value.(type) {
+	case bool:
+		return "checkbox"
+	case float64, int:
+		return "number"
+	case []interface{}:
+		return "tag"
+	}
+
+	return "textbox"
+}
+
+var acronyms = map[string]bool{"Api": true, "Id": true, "Rpc": true, "Ssl": true, "Url": true}
+
+// label turns a camel case field name into the label shown in the UI.
+func label(field string) string {
+	var words []string
+
+	start := 0
+
+	for i, r := range field {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			words = append(words, field[start:i])
+			start = i
+		}
+	}
+
+	words = append(words, field[start:])
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+		if acronyms[words[i]] {
+			words[i] = strings.ToUpper(w)
+		}
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,341 @@
+package testserver
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// show describes a series known by the fake metadata source.
+type show struct {
+	title   string
+	slug    string
+	imdbID  string
+	status  string
+	network string
+	pilot   string
+	tvdbID  int
+	year    int
+	seasons int
+}
+
+// catalog replaces the online metadata source used by the series lookup.
+var catalog = []show{
+	{tvdbID: 73244, title: "The Office (US)", slug: "the-office-us", imdbID: "tt0386676", year: 2005, status: "ended", network: "NBC", pilot: "Pilot", seasons: 9},
+	{tvdbID: 78107, title: "The Office", slug: "the-office", imdbID: "tt0290978", year: 2001, status: "ended", network: "BBC Two", pilot: "Downsize", seasons: 2},
+	{tvdbID: 73739, title: "Lost", slug: "lost", imdbID: "tt0411008", year: 2004, status: "ended", network: "ABC", pilot: "Pilot (1)", seasons: 6},
+	{tvdbID: 76885, title: "Cowboy Bebop", slug: "cowboy-bebop", imdbID: "tt0213338", year: 1998, status: "ended", network: "TV Tokyo", pilot: "Asteroid Blues", seasons: 1},
+	{tvdbID: 78874, title: "Firefly", slug: "firefly", imdbID: "tt0303461", year: 2002, status: "ended", network: "FOX", pilot: "Serenity", seasons: 1},
+	{tvdbID: 79089, title: "Samurai Champloo", slug: "samurai-champloo", imdbID: "tt0423731", year: 2004, status: "ended", network: "Fuji TV", pilot: "Tempestuous Temperaments", seasons: 1},
+	{tvdbID: 79168, title: "Friends", slug: "friends", imdbID: "tt0108778", year: 1994, status: "ended", network: "NBC", pilot: "The One Where Monica Gets a Roommate", seasons: 10},
+	{tvdbID: 81189, title: "Breaking Bad", slug: "breaking-bad", imdbID: "tt0903747", year: 2008, status: "ended", network: "AMC", pilot: "Pilot", seasons: 5},
+	{tvdbID: 153021, title: "The Walking Dead", slug: "the-walking-dead", imdbID: "tt1520211", year: 2010, status: "ended", network: "AMC", pilot: "Days Gone Bye", seasons: 11},
+	{tvdbID: 176941, title: "Sherlock", slug: "sherlock", imdbID: "tt1475582", year: 2010, status: "ended", network: "BBC One", pilot: "A Study in Pink", seasons: 4},
+	{tvdbID: 269613, title: "Fargo", slug: "fargo", imdbID: "tt2802850", year: 2014, status: "continuing", network: "FX", pilot: "The Crocodile's Dilemma", seasons: 5},
+	{tvdbID: 360893, title: "Chernobyl", slug: "chernobyl", imdbID: "tt7366338", year: 2019, status: "ended", network: "HBO", pilot: "1:23:45", seasons: 1},
+}
+
+const (
+	// episodesPerSeason keeps the generated episode list small.
+	episodesPerSeason = 3
+	// refreshDelay is the time Sonarr takes to fetch the episodes of a new series in background.
+	refreshDelay = 200 * time.Millisecond
+)
+
+// refresh is a pending series refresh, adding the episodes once due.
+type refresh struct {
+	due      time.Time
+	monitor  string
+	seriesID int
+}
+
+func findShow(tvdbID int) (show, bool) {
+	for _, s := range catalog {
+		if s.tvdbID == tvdbID {
+			return s, true
+		}
+	}
+
+	return show{}, false
+}
+
+func (s show) object() object {
+	seasons := make([]interface{}, s.seasons)
+	for i := range seasons {
+		seasons[i] = object{"seasonNumber": i + 1, "monitored": true}
+	}
+
+	return object{
+		"title": s.title, "sortTitle": strings.ToLower(s.title), "titleSlug": s.slug, "tvdbId": s.tvdbID, "imdbId": s.imdbID,
+		"year": s.year, "status": s.status, "network": s.network, "seasons": seasons, "seriesType": "standard",
+		"monitorNewItems": "all", "seasonFolder": true, "genres": []interface{}{"Drama"}, "images": []interface{}{},
+		"folder": s.title, "tags": []interface{}{}, "qualityProfileId": 0, "monitored": true, "useSceneNumbering": false,
+	}
+}
+
+// lookupSeries supports the tvdb and imdb prefixes, plain TVDB IDs and partial titles.
+func lookupSeries(term string) []object {
+	term = strings.ToLower(strings.TrimSpace(term))
+	found := []object{}
+
+	for _, s := range catalog {
+		var match bool
+
+		switch {
+		case strings.HasPrefix(term, "tvdb:"):
+			match = strings.TrimPrefix(term, "tvdb:") == strconv.Itoa(s.tvdbID)
+		case strings.HasPrefix(term, "imdb:"):
+			match = strings.TrimPrefix(term, "imdb:") == s.imdbID
+		case strings.HasPrefix(term, "tmdb:"):
+			match = false
+		default:
+			match = term == strconv.Itoa(s.tvdbID) || strings.Contains(strings.ToLower(s.title), term)
+		}
+
+		if match {
+			found = append(found, s.object())
+		}
+	}
+
+	return found
+}
+
+// addSeries fills the metadata of a new series and queues the refresh adding its episodes.
+func (s *Server) addSeries(series object) {
+	tvdbID := toInt(series["tvdbId"])
+
+	meta, ok := findShow(tvdbID)
+	if !ok {
+		meta = show{tvdbID: tvdbID, title: stringValue(series["title"]), status: "continuing", pilot: "Pilot", seasons: 1}
+	}
+
+	for k, v := range meta.object() {
+		if _, ok := series[k]; !ok || k == "year" || k == "imdbId" || k == "status" || k == "network" {
+			series[k] = v
+		}
+	}
+
+	// The path is built from the root folder only when missing
+	if stringValue(series["path"]) == "" {
+		series["path"] = path.Join(stringValue(series["rootFolderPath"]), stringValue(series["folder"]))
+	}
+
+	monitor := "all"
+	if options, ok := series["addOptions"].(object); ok && options["monitor"] != nil {
+		monitor = stringValue(options["monitor"])
+	}
+
+	s.refreshes = append(s.refreshes, refresh{due: time.Now().Add(refreshDelay), monitor: monitor, seriesID: toInt(series["id"])})
+	s.updateSeries(series)
+}
+
+// runRefreshes adds the episodes of the series whose refresh is due.
+func (s *Server) runRefreshes(now time.Time) {
+	pending := s.refreshes[:0]
+
+	for _, r := range s.refreshes {
+		if now.Before(r.due) {
+			pending = append(pending, r)
+
+			continue
+		}
+
+		// the series may have been deleted meanwhile
+		if series, ok := s.collections["series"][r.seriesID]; ok {
+			s.addEpisodes(series, r.monitor)
+		}
+	}
+
+	s.refreshes = pending
+}
+
+// addEpisodes generates the episodes of a series.
+func (s *Server) addEpisodes(series object, monitor string) {
+	tvdbID := toInt(series["tvdbId"])
+
+	meta, ok := findShow(tvdbID)
+	if !ok {
+		meta = show{tvdbID: tvdbID, pilot: "Pilot", seasons: 1}
+	}
+
+	for season := 1; season <= meta.seasons; season++ {
+		for number := 1; number <= episodesPerSeason; number++ {
+			title := "Episode " + strconv.Itoa(number)
+			if season == 1 && number == 1 {
+				title = meta.pilot
+			}
+
+			s.insert("episode", object{
+				"seriesId": series["id"], "tvdbId": tvdbID*1000 + season*100 + number, "episodeFileId": 0,
+				"seasonNumber": season, "episodeNumber": number, "title": title, "overview": "",
+				"hasFile": false, "monitored": monitor != "none" && seasonMonitored(series, season),
+				"absoluteEpisodeNumber": (season-1)*episodesPerSeason + number, "unverifiedSceneNumbering": false,
+			})
+		}
+	}
+
+	s.updateSeries(series)
+}
+
+// updateSeries refreshes the computed root folder and statistics.
+func (s *Server) updateSeries(series object) {
+	series["rootFolderPath"] = s.rootFolderFor(stringValue(series["path"]))
+
+	var count int
+
+	for _, episode := range s.collections["episode"] {
+		if toInt(episode["seriesId"]) == toInt(series["id"]) {
+			count++
+		}
+	}
+
+	seasons, _ := series["seasons"].([]interface{})
+	series["statistics"] = object{
+		"seasonCount": len(seasons), "episodeFileCount": 0, "episodeCount": 0, "totalEpisodeCount": count,
+		"sizeOnDisk": 0, "percentOfEpisodes": 0, "releaseGroups": []interface{}{},
+	}
+}
+
+// rootFolderFor returns the root folder holding the path, like Sonarr does,
+// falling back to the parent folder when no root folder matches.
+func (s *Server) rootFolderFor(seriesPath string) string {
+	best := ""
+
+	for _, folder := range s.collections["rootfolder"] {
+		root := strings.TrimSuffix(stringValue(folder["path"]), "/")
+		if strings.HasPrefix(seriesPath, root+"/") && len(root) > len(best) {
+			best = root
+		}
+	}
+
+	if best == "" {
+		return path.Dir(seriesPath)
+	}
+
+	return best
+}
+
+func (s *Server) deleteEpisodes(seriesID int) {
+	for id, episode := range s.collections["episode"] {
+		if toInt(episode["seriesId"]) == seriesID {
+			delete(s.collections["episode"], id)
+		}
+	}
+}
+
+func (s *Server) listEpisodes(seriesID, seasonNumber string) []object {
+	episodes := []object{}
+
+	for _, episode := range s.list("episode") {
+		if seriesID != "" && strconv.Itoa(toInt(episode["seriesId"])) != seriesID {
+			continue
+		}
+
+		if seasonNumber != "" && strconv.Itoa(toInt(episode["seasonNumber"])) != seasonNumber {
+			continue
+		}
+
+		episodes = append(episodes, episode)
+	}
+
+	return episodes
+}
+
+func (s *Server) monitorEpisodes(body interface{}) (int, interface{}) {
+	request, ok := body.(object)
+	if !ok {
+		return http.StatusBadRequest, nil
+	}
+
+	ids, _ := request["episodeIds"].([]interface{})
+	for _, id := range ids {
+		if episode, ok := s.collections["episode"][toInt(id)]; ok {
+			episode["monitored"] = request["monitored"] == true
+		}
+	}
+
+	return http.StatusAccepted, nil
+}
+
+// editSeries applies the series editor changes to every selected series.
+func (s *Server) editSeries(body interface{}) (int, interface{}) {
+	request, ok := body.(object)
+	if !ok {
+		return http.StatusBadRequest, nil
+	}
+
+	if id := toInt(request["qualityProfileId"]); request["qualityProfileId"] != nil && s.collections["qualityprofile"][id] == nil {
+		return http.StatusBadRequest, []object{failure("QualityProfileId", "Quality Profile does not exist")}
+	}
+
+	ids, _ := request["seriesIds"].([]interface{})
+	edited := []object{}
+
+	for _, id := range ids {
+		series, ok := s.collections["series"][toInt(id)]
+		if !ok {
+			continue
+		}
+
+		for _, field := range []string{"monitored", "monitorNewItems", "qualityProfileId", "seriesType", "seasonFolder"} {
+			if value, ok := request[field]; ok && value != nil {
+				series[field] = value
+			}
+		}
+
+		if root := stringValue(request["rootFolderPath"]); root != "" {
+			series["path"] = path.Join(root, path.Base(stringValue(series["path"])))
+			series["rootFolderPath"] = s.rootFolderFor(stringValue(series["path"]))
+		}
+
+		if tags, ok := request["tags"].([]interface{}); ok {
+			series["tags"] = applyTags(series["tags"], tags, stringValue(request["applyTags"]))
+		}
+
+		edited = append(edited, series)
+	}
+
+	return http.StatusAccepted, edited
+}
+
+func applyTags(current interface{}, tags []interface{}, mode string) []interface{} {
+	existing, _ := current.([]interface{})
+
+	switch mode {
+	case "replace":
+		return tags
+	case "remove":
+		result := []interface{}{}
+
+		for _, tag := range existing {
+			if !containsInt(tags, tag) {
+				result = append(result, tag)
+			}
+		}
+
+		return result
+	default:
+		result := append([]interface{}{}, existing...)
+
+		for _, tag := range tags {
+			if !containsInt(result, tag) {
+				result = append(result, tag)
+			}
+		}
+
+		return result
+	}
+}
+
+func seasonMonitored(series object, number int) bool {
+	seasons, _ := series["seasons"].([]interface{})
+	for _, season := range seasons {
+		if season, ok := season.(object); ok && toInt(season["seasonNumber"]) == number {
+			return season["monitored"] == true
+		}
+	}
+
+	return series["monitored"] == true
+}
@@ -0,0 +1,267 @@
+// Package testserver provides an in-memory fake Sonarr, so that the provider tests can run offline.
+package testserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// APIKey is the key accepted by the fake server.
+const APIKey = "testserver-api-key"
+
+const apiPrefix = "/api/v3/"
+
+type object = map[string]interface{}
+
+// Server is a fake Sonarr keeping every object in memory.
+type Server struct {
+	*httptest.Server
+	collections map[string]map[int]object
+	singletons  map[string]object
+	nextID      map[string]int
+	refreshes   []refresh
+	mu          sync.Mutex
+}
+
+// New starts a fake Sonarr seeded with the default objects of a fresh installation.
+// No root folder is seeded, the tests create the ones they need.
+func New() *Server {
+	s := &Server{
+		collections: make(map[string]map[int]object),
+		singletons:  make(map[string]object),
+		nextID:      make(map[string]int),
+	}
+	s.seed()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+
+	return s
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Api-Key") != APIKey && r.URL.Query().Get("apikey") != APIKey {
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, apiPrefix) {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	var body interface{}
+
+	if r.Body != nil && r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeJSON(w, http.StatusBadRequest, object{"message": err.Error()})
+
+			return
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.runRefreshes(time.Now())
+
+	status, response := s.route(r, strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, apiPrefix), "/"), "/"), body)
+	writeJSON(w, status, response)
+}
+
+// route dispatches the request to the special endpoints first, then to the generic ones.
+func (s *Server) route(r *http.Request, segments []string, body interface{}) (int, interface{}) {
+	path := strings.Join(segments, "/")
+
+	switch {
+	case path == "system/status":
+		return http.StatusOK, systemStatus()
+	case path == "series/lookup":
+		return http.StatusOK, lookupSeries(r.URL.Query().Get("term"))
+	case path == "series/editor" && r.Method == http.MethodPut:
+		return s.editSeries(body)
+	case path == "episode/monitor" && r.Method == http.MethodPut:
+		return s.monitorEpisodes(body)
+	case path == "episode" && r.Method == http.MethodGet:
+		return http.StatusOK, s.listEpisodes(r.URL.Query().Get("seriesId"), r.URL.Query().Get("seasonNumber"))
+	case segments[0] == "config" && len(segments) > 1:
+		return s.singleton(r.Method, segments[1], body)
+	case len(segments) == 2 && segments[1] == "schema":
+		return http.StatusOK, schema(segments[0])
+	}
+
+	status, response := s.collection(r, segments, body)
+
+	return status, mask(segments[0], response)
+}
+
+func (s *Server) singleton(method, name string, body interface{}) (int, interface{}) {
+	current, ok := s.singletons[name]
+	if !ok {
+		return http.StatusNotFound, nil
+	}
+
+	if method == http.MethodPut {
+		update, ok := body.(object)
+		if !ok {
+			return http.StatusBadRequest, nil
+		}
+
+		for k, v := range update {
+			current[k] = v
+		}
+
+		current["id"] = 1
+	}
+
+	return http.StatusOK, current
+}
+
+func (s *Server) collection(r *http.Request, segments []string, body interface{}) (int, interface{}) {
+	name := segments[0]
+
+	if _, ok := s.collections[name]; !ok {
+		return http.StatusNotFound, nil
+	}
+
+	// Collection level
+	if len(segments) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			return http.StatusOK, s.list(name)
+		case http.MethodPost:
+			item, ok := body.(object)
+			if !ok {
+				return http.StatusBadRequest, nil
+			}
+
+			return s.create(name, item)
+		case http.MethodPut:
+			// some endpoints update without ID in path
+			item, _ := body.(object)
+			id, _ := item["id"].(float64)
+
+			return s.update(name, int(id), item)
+		}
+
+		return http.StatusMethodNotAllowed, nil
+	}
+
+	id, err := strconv.Atoi(segments[1])
+	if err != nil || len(segments) > 2 {
+		return http.StatusNotFound, nil
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if item, ok := s.collections[name][id]; ok {
+			return http.StatusOK, item
+		}
+
+		return http.StatusNotFound, notFound(name)
+	case http.MethodPut:
+		item, ok := body.(object)
+		if !ok {
+			return http.StatusBadRequest, nil
+		}
+
+		return s.update(name, id, item)
+	case http.MethodDelete:
+		if _, ok := s.collections[name][id]; !ok {
+			return http.StatusNotFound, notFound(name)
+		}
+
+		delete(s.collections[name], id)
+
+		if name == "series" {
+			s.deleteEpisodes(id)
+		}
+
+		return http.StatusOK, object{}
+	}
+
+	return http.StatusMethodNotAllowed, nil
+}
+
+func (s *Server) list(name string) []object {
+	ids := make([]int, 0, len(s.collections[name]))
+	for id := range s.collections[name] {
+		ids = append(ids, id)
+	}
+
+	sort.Ints(ids)
+
+	items := make([]object, len(ids))
+	for i, id := range ids {
+		items[i] = s.collections[name][id]
+	}
+
+	return items
+}
+
+func (s *Server) create(name string, item object) (int, interface{}) {
+	if failures := s.validate(name, 0, item); len(failures) > 0 {
+		return http.StatusBadRequest, failures
+	}
+
+	s.insert(name, item)
+
+	if name == "series" {
+		s.addSeries(item)
+	}
+
+	return http.StatusCreated, item
+}
+
+func (s *Server) update(name string, id int, item object) (int, interface{}) {
+	current, ok := s.collections[name][id]
+	if !ok {
+		return http.StatusNotFound, notFound(name)
+	}
+
+	if failures := s.validate(name, id, item); len(failures) > 0 {
+		return http.StatusBadRequest, failures
+	}
+
+	// The whole resource is replaced, as Sonarr does
+	keepPrivateFields(name, current, item)
+
+	item["id"] = id
+	normalize(name, item)
+	s.collections[name][id] = item
+
+	if name == "series" {
+		s.updateSeries(item)
+	}
+
+	return http.StatusAccepted, item
+}
+
+// insert stores an object assigning a new ID.
+func (s *Server) insert(name string, item object) object {
+	s.nextID[name]++
+	item["id"] = s.nextID[name]
+	normalize(name, item)
+	s.collections[name][s.nextID[name]] = item
+
+	return item
+}
+
+func notFound(name string) object {
+	return object{"message": "NotFound", "description": name + " not found"}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
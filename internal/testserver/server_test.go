@@ -0,0 +1,223 @@
+package testserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func testClient(url, key string) *sonarr.APIClient {
+	config := sonarr.NewConfiguration()
+	config.AddDefaultHeader("X-Api-Key", key)
+	config.Servers[0].URL = url
+
+	return sonarr.NewAPIClient(config)
+}
+
+func TestServerAuthentication(t *testing.T) {
+	t.Parallel()
+
+	server := New()
+	t.Cleanup(server.Close)
+
+	_, resp, err := testClient(server.URL, "wrong").SystemAPI.GetSystemStatus(context.Background()).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	status, _, err := testClient(server.URL, APIKey).SystemAPI.GetSystemStatus(context.Background()).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, Version, status.GetVersion())
+}
+
+func TestServerTag(t *testing.T) {
+	t.Parallel()
+
+	server := New()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := testClient(server.URL, APIKey)
+
+	tag := sonarr.NewTagResource()
+	tag.SetLabel("HD")
+
+	created, _, err := client.TagAPI.CreateTag(ctx).TagResource(*tag).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "hd", created.GetLabel())
+
+	_, resp, err := client.TagAPI.CreateTag(ctx).TagResource(*tag).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, string(err.(*sonarr.GenericOpenAPIError).Body()), `"propertyName":"Label"`)
+
+	_, err = client.TagAPI.DeleteTag(ctx, created.GetId()).Execute()
+	assert.NoError(t, err)
+
+	_, resp, err = client.TagAPI.GetTagById(ctx, created.GetId()).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestServerSeries(t *testing.T) {
+	t.Parallel()
+
+	server := New()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := testClient(server.URL, APIKey)
+
+	folder := sonarr.NewRootFolderResource()
+	folder.SetPath("/config")
+
+	_, _, err := client.RootFolderAPI.CreateRootFolder(ctx).RootFolderResource(*folder).Execute()
+	assert.NoError(t, err)
+
+	lookup, _, err := client.SeriesLookupAPI.ListSeriesLookup(ctx).Term("Office").Execute()
+	assert.NoError(t, err)
+	assert.Len(t, lookup, 2)
+
+	lookup, _, err = client.SeriesLookupAPI.ListSeriesLookup(ctx).Term("imdb:tt0303461").Execute()
+	assert.NoError(t, err)
+	assert.Len(t, lookup, 1)
+
+	series := lookup[0]
+	series.SetQualityProfileId(99)
+	series.SetPath("/config/firefly")
+
+	_, _, err = client.SeriesAPI.CreateSeries(ctx).SeriesResource(series).Execute()
+	assert.Error(t, err)
+
+	series.SetQualityProfileId(1)
+
+	created, _, err := client.SeriesAPI.CreateSeries(ctx).SeriesResource(series).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "/config", created.GetRootFolderPath())
+	assert.Equal(t, int32(1), created.Statistics.GetSeasonCount())
+
+	// episodes are added by the background refresh
+	episodes, _, err := client.EpisodeAPI.ListEpisode(ctx).SeriesId(created.GetId()).SeasonNumber(1).Execute()
+	assert.NoError(t, err)
+	assert.Empty(t, episodes)
+
+	if assert.Eventually(t, func() bool {
+		episodes, _, err = client.EpisodeAPI.ListEpisode(ctx).SeriesId(created.GetId()).SeasonNumber(1).Execute()
+
+		return err == nil && len(episodes) == episodesPerSeason
+	}, 10*refreshDelay, refreshDelay/4) {
+		assert.Equal(t, "Serenity", episodes[0].GetTitle())
+	}
+
+	editor := sonarr.NewSeriesEditorResource()
+	editor.SetSeriesIds([]int32{created.GetId()})
+	editor.SetSeriesType(sonarr.SERIESTYPES_ANIME)
+
+	_, err = client.SeriesEditorAPI.PutSeriesEditor(ctx).SeriesEditorResource(*editor).Execute()
+	assert.NoError(t, err)
+
+	read, _, err := client.SeriesAPI.GetSeriesById(ctx, created.GetId()).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, sonarr.SERIESTYPES_ANIME, read.GetSeriesType())
+}
+
+func TestServerConfig(t *testing.T) {
+	t.Parallel()
+
+	server := New()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := testClient(server.URL, APIKey)
+
+	config, _, err := client.IndexerConfigAPI.GetIndexerConfig(ctx).Execute()
+	assert.NoError(t, err)
+
+	config.SetRssSyncInterval(30)
+
+	_, _, err = client.IndexerConfigAPI.UpdateIndexerConfig(ctx, "1").IndexerConfigResource(*config).Execute()
+	assert.NoError(t, err)
+
+	config, _, err = client.IndexerConfigAPI.GetIndexerConfig(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(30), config.GetRssSyncInterval())
+}
+
+func TestServerDownloadClient(t *testing.T) {
+	t.Parallel()
+
+	server := New()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := testClient(server.URL, APIKey)
+
+	templates, _, err := client.DownloadClientAPI.ListDownloadClientSchema(ctx).Execute()
+	assert.NoError(t, err)
+	assert.Len(t, templates, len(implementations["downloadclient"]))
+
+	var sabnzbd sonarr.DownloadClientResource
+
+	for _, template := range templates {
+		if template.GetImplementation() == "Sabnzbd" {
+			sabnzbd = template
+		}
+	}
+
+	assert.Equal(t, "SabnzbdSettings", sabnzbd.GetConfigContract())
+	assert.Equal(t, sonarr.DOWNLOADPROTOCOL_USENET, sabnzbd.GetProtocol())
+
+	for i, field := range sabnzbd.Fields {
+		switch field.GetName() {
+		case "apiKey":
+			assert.Equal(t, "API Key", field.GetLabel())
+			assert.Equal(t, sonarr.PRIVACYLEVEL_API_KEY, field.GetPrivacy())
+			sabnzbd.Fields[i].SetValue("secret")
+		case "host":
+			assert.Equal(t, sonarr.PRIVACYLEVEL_NORMAL, field.GetPrivacy())
+			sabnzbd.Fields[i].SetValue("localhost")
+		}
+	}
+
+	sabnzbd.SetName("Test")
+
+	created, _, err := client.DownloadClientAPI.CreateDownloadClient(ctx).DownloadClientResource(sabnzbd).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "Sabnzbd", created.GetImplementationName())
+	assert.Equal(t, "https://wiki.servarr.com/sonarr/supported#sabnzbd", created.GetInfoLink())
+	assert.Equal(t, map[string]interface{}{"apiKey": "********", "host": "localhost"}, testFieldValues(created.Fields, "apiKey", "host"))
+
+	// sending back the masked value keeps the stored one
+	for i, field := range created.Fields {
+		if field.GetName() == "host" {
+			created.Fields[i].SetValue("sabnzbd")
+		}
+	}
+
+	updated, _, err := client.DownloadClientAPI.UpdateDownloadClient(ctx, created.GetId()).DownloadClientResource(*created).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"apiKey": "********", "host": "sabnzbd"}, testFieldValues(updated.Fields, "apiKey", "host"))
+
+	stored, _ := server.collections["downloadclient"][int(created.GetId())]["fields"].([]interface{})
+	for _, f := range stored {
+		if field, _ := f.(object); field["name"] == "apiKey" {
+			assert.Equal(t, "secret", field["value"])
+		}
+	}
+}
+
+func testFieldValues(fields []sonarr.Field, names ...string) map[string]interface{} {
+	values := make(map[string]interface{})
+
+	for _, field := range fields {
+		for _, name := range names {
+			if field.GetName() == name {
+				values[name] = field.GetValue()
+			}
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,159 @@
+package testserver
+
+import (
+	"strings"
+)
+
+// namedCollections require a unique name, as Sonarr does for providers and profiles.
+var namedCollections = map[string]bool{
+	"autotagging":    true,
+	"customformat":   true,
+	"downloadclient": true,
+	"importlist":     true,
+	"indexer":        true,
+	"metadata":       true,
+	"notification":   true,
+	"qualityprofile": true,
+}
+
+// taggedCollections always return a tag list.
+var taggedCollections = map[string]bool{
+	"autotagging":    true,
+	"delayprofile":   true,
+	"downloadclient": true,
+	"importlist":     true,
+	"indexer":        true,
+	"metadata":       true,
+	"notification":   true,
+	"releaseprofile": true,
+	"series":         true,
+}
+
+// failure mimics a Sonarr validation failure.
+func failure(property, message string) object {
+	return object{"propertyName": property, "errorMessage": message, "severity": "error", "isWarning": false}
+}
+
+// validate returns the validation failures of the item, ignoring the item with the given ID on uniqueness checks.
+func (s *Server) validate(name string, id int, item object) []object {
+	failures := []object{}
+
+	if namedCollections[name] {
+		switch itemName := stringValue(item["name"]); {
+		case itemName == "":
+			failures = append(failures, failure("Name", "'Name' must not be empty."))
+		case s.exists(name, id, "name", itemName):
+			failures = append(failures, failure("Name", "Should be unique"))
+		}
+	}
+
+	switch name {
+	case "tag":
+		switch label := strings.ToLower(stringValue(item["label"])); {
+		case label == "":
+			failures = append(failures, failure("Label", "'Label' must not be empty."))
+		case s.exists(name, id, "label", label):
+			failures = append(failures, failure("Label", "Should be unique"))
+		}
+	case "rootfolder":
+		switch folder := stringValue(item["path"]); {
+		case !strings.HasPrefix(folder, "/"):
+			failures = append(failures, failure("Path", "Invalid Path"))
+		case s.exists(name, id, "path", folder):
+			failures = append(failures, failure("Path", "Path is already configured as a root folder"))
+		}
+	case "series":
+		if toInt(item["tvdbId"]) == 0 {
+			failures = append(failures, failure("TvdbId", "'Tvdb Id' must be greater than '0'."))
+		} else if s.exists(name, id, "tvdbId", toInt(item["tvdbId"])) {
+			failures = append(failures, failure("TvdbId", "This series has already been added"))
+		}
+
+		if _, ok := s.collections["qualityprofile"][toInt(item["qualityProfileId"])]; !ok {
+			failures = append(failures, failure("QualityProfileId", "Quality Profile does not exist"))
+		}
+
+		if !strings.HasPrefix(stringValue(item["path"]), "/") {
+			failures = append(failures, failure("Path", "Invalid Path"))
+		}
+	case "importlistexclusion":
+		if s.exists(name, id, "tvdbId", toInt(item["tvdbId"])) {
+			failures = append(failures, failure("TvdbId", "This exclusion has already been added."))
+		}
+	case "remotepathmapping":
+		if stringValue(item["host"]) == "" {
+			failures = append(failures, failure("Host", "'Host' must not be empty."))
+		}
+	}
+
+	return failures
+}
+
+// exists checks if another item has the same value, comparing strings case insensitively.
+func (s *Server) exists(name string, id int, field string, value interface{}) bool {
+	for itemID, item := range s.collections[name] {
+		if itemID == id {
+			continue
+		}
+
+		switch v := value.(type) {
+		case string:
+			if strings.EqualFold(stringValue(item[field]), v) {
+				return true
+			}
+		case int:
+			if toInt(item[field]) == v {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// normalize sets the values Sonarr computes on save.
+func normalize(name string, item object) {
+	if _, ok := item["tags"]; !ok && taggedCollections[name] {
+		item["tags"] = []interface{}{}
+	}
+
+	if _, ok := implementations[name]; ok {
+		deriveProvider(name, item)
+	}
+
+	switch name {
+	case "tag":
+		item["label"] = strings.ToLower(stringValue(item["label"]))
+	case "rootfolder":
+		item["accessible"] = true
+		item["freeSpace"] = 0
+		item["unmappedFolders"] = []interface{}{}
+	}
+}
+
+func stringValue(value interface{}) string {
+	s, _ := value.(string)
+
+	return s
+}
+
+func toInt(value interface{}) int {
+	switch v := value.(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	}
+
+	return 0
+}
+
+func containsInt(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if toInt(v) == toInt(value) {
+			return true
+		}
+	}
+
+	return false
+}